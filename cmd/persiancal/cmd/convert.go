@@ -1,10 +1,10 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
-	"strconv"
 	"strings"
-	"time"
+	"unicode/utf8"
 
 	"github.com/CHashtager/persiancal/pkg/persiancal"
 	"github.com/spf13/cobra"
@@ -15,69 +15,86 @@ var convertCmd = &cobra.Command{
 	Short: "Convert between Gregorian and Jalali dates",
 	Long: `Convert a date between Gregorian and Jalali calendars.
 	
-By default, converts Gregorian to Jalali.
-Use --reverse to convert Jalali to Gregorian.
+The calendar is detected from the year: 1200-1499 is Jalali and is
+converted to Gregorian, 1800-2199 is Gregorian and is converted to Jalali.
+Use --reverse to treat the date as Jalali regardless of the year.
+
+--format takes the same tokens in both directions; MMMM and MMM are the
+Persian and English names of the month in the output calendar.
 
 Supported input formats:
   - yyyy-MM-dd (e.g., 2025-10-26)
   - yyyy/MM/dd (e.g., 2025/10/26)
-  - yyyy.MM.dd (e.g., 2025.10.26)`,
+  - yyyy.MM.dd (e.g., 2025.10.26)
+  - dd-MM-yyyy, dd/MM/yyyy, dd.MM.yyyy (e.g., 26/10/2025)
+  - dd MMMM yyyy (e.g., 04 آبان 1404, 26 October 2025)`,
 	Example: `  persiancal convert 2025-10-26
+  persiancal convert 1404-08-04
   persiancal convert 1404-08-04 --reverse
+  persiancal convert 1404-08-04 --format "EEEE d MMMM yyyy"
   persiancal convert 2025-10-26 --format "MMMM dd, yyyy"
+  persiancal convert 2025-10-26 --strftime "%A %d %B %Y"
   persiancal convert 2025-10-26 --persian`,
 	Args: cobra.ExactArgs(1),
 	RunE: runConvert,
 }
 
 var (
-	convertReverse bool
-	convertFormat  string
+	convertReverse  bool
+	convertFormat   string
+	convertStrftime string
 )
 
 func init() {
 	rootCmd.AddCommand(convertCmd)
 
-	convertCmd.Flags().BoolVarP(&convertReverse, "reverse", "r", false, "Convert from Jalali to Gregorian")
-	convertCmd.Flags().StringVarP(&convertFormat, "format", "f", "", "Output format layout")
+	convertCmd.Flags().BoolVarP(&convertReverse, "reverse", "r", false, "Treat the date as Jalali and convert it to Gregorian")
+	convertCmd.Flags().StringVarP(&convertFormat, "format", "f", "", "Output format layout (e.g., 'dd MMMM yyyy')")
+	convertCmd.Flags().StringVar(&convertStrftime, "strftime", "", "strftime format for Jalali output (e.g., '%Y/%m/%d')")
+	convertCmd.MarkFlagsMutuallyExclusive("format", "strftime")
 }
 
 func runConvert(cmd *cobra.Command, args []string) error {
 	usePersian, _ := cmd.Flags().GetBool("persian")
-	dateStr := args[0]
-
-	dateStr = persiancal.ToLatinDigits(dateStr)
 
+	var opts persiancal.ParseAnyOptions
 	if convertReverse {
-		j, err := parseJalaliDate(dateStr)
-		if err != nil {
-			return fmt.Errorf("failed to parse Jalali date: %w", err)
-		}
+		opts.Calendar = persiancal.SolarHijriCalendar
+	}
+	r, err := parseDate(args[0], &opts)
+	if err != nil {
+		return fmt.Errorf("failed to parse date: %w", err)
+	}
 
-		g := j.ToGregorian()
+	if r.Calendar == persiancal.SolarHijriCalendar {
+		if convertStrftime != "" {
+			return fmt.Errorf("--strftime formats Jalali dates; use --format for Gregorian output")
+		}
+		g := r.Time()
 
-		var output string
+		layout := "yyyy-MM-dd"
 		if convertFormat != "" {
-			output = g.Format(convertFormat)
-		} else {
-			output = g.Format("2006-01-02")
+			layout = convertFormat
 		}
 
+		var output string
 		if usePersian {
-			output = persiancal.ToPersianDigits(output)
+			output = persiancal.FormatGregorianPersian(g, layout)
+		} else {
+			output = persiancal.FormatGregorian(g, layout)
 		}
 
-		fmt.Println(output)
+		return printDate(cmd, output)
 	} else {
-		g, err := parseGregorianDate(dateStr)
-		if err != nil {
-			return fmt.Errorf("failed to parse Gregorian date: %w", err)
-		}
-
-		j := persiancal.FromGregorianDate(g)
+		j := r.Date
 
 		var output string
-		if convertFormat != "" {
+		if convertStrftime != "" {
+			output = j.Strftime(convertStrftime)
+			if usePersian {
+				output = persiancal.ToPersianDigits(output)
+			}
+		} else if convertFormat != "" {
 			if usePersian {
 				output = j.FormatPersian(convertFormat)
 			} else {
@@ -90,65 +107,26 @@ func runConvert(cmd *cobra.Command, args []string) error {
 			}
 		}
 
-		fmt.Println(output)
+		return printDate(cmd, output)
 	}
-
-	return nil
 }
 
-func parseJalaliDate(dateStr string) (persiancal.JalaliDate, error) {
-	separators := []string{"-", "/", "."}
-
-	for _, sep := range separators {
-		if strings.Contains(dateStr, sep) {
-			parts := strings.Split(dateStr, sep)
-			if len(parts) != 3 {
-				continue
-			}
-
-			year, err := strconv.Atoi(parts[0])
-			if err != nil {
-				continue
-			}
-
-			month, err := strconv.Atoi(parts[1])
-			if err != nil {
-				continue
-			}
-
-			day, err := strconv.Atoi(parts[2])
-			if err != nil {
-				continue
-			}
-
-			j, err := persiancal.New(year, month, day)
-			if err != nil {
-				return persiancal.JalaliDate{}, err
-			}
-
-			return j, nil
-		}
+// parseDate parses a Jalali or Gregorian date in any supported layout
+func parseDate(dateStr string, opts *persiancal.ParseAnyOptions) (persiancal.ParseResult, error) {
+	r, err := persiancal.ParseAny(dateStr, opts)
+	if err != nil {
+		return persiancal.ParseResult{}, withCaret(err)
 	}
-
-	return persiancal.JalaliDate{}, fmt.Errorf("unsupported date format: %s", dateStr)
+	return r, nil
 }
 
-func parseGregorianDate(dateStr string) (time.Time, error) {
-	formats := []string{
-		"2006-01-02",
-		"2006/01/02",
-		"2006.01.02",
-		"02-01-2006",
-		"02/01/2006",
-		"02.01.2006",
+// withCaret appends the parsed value and a caret under the bad character
+// to a *persiancal.ParseError
+func withCaret(err error) error {
+	var pe *persiancal.ParseError
+	if !errors.As(err, &pe) {
+		return err
 	}
-
-	for _, format := range formats {
-		t, err := time.Parse(format, dateStr)
-		if err == nil {
-			return t, nil
-		}
-	}
-
-	return time.Time{}, fmt.Errorf("unsupported date format: %s", dateStr)
+	pad := strings.Repeat(" ", utf8.RuneCountInString(pe.Value[:pe.Offset]))
+	return fmt.Errorf("%w\n  %s\n  %s^", err, pe.Value, pad)
 }
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/CHashtager/persiancal/pkg/persiancal"
+)
+
+func TestParseErrorCaret(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string // the last two lines of the error
+	}{
+		{[]string{"convert", "1404/08-04"}, "  1404/08-04\n         ^"},
+		// The caret counts characters, not bytes
+		{[]string{"convert", "۱۴۰۴/۰۸/۰۴x"}, "  ۱۴۰۴/۰۸/۰۴x\n            ^"},
+		{[]string{"convert", "١٤٠٤/٠٨/٠٤"}, "  ١٤٠٤/٠٨/٠٤\n  ^"},
+		{[]string{"diff", "1404/08/04", "1404/08/0x"}, "  1404/08/0x\n           ^"},
+	}
+	for _, tt := range tests {
+		_, err := execute(t, tt.args...)
+		var pe *persiancal.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("%q error = %v, want a *ParseError", tt.args, err)
+			continue
+		}
+		if !strings.HasSuffix(err.Error(), "\n"+tt.want) {
+			t.Errorf("%q error = %q, want it to end with %q", tt.args, err, tt.want)
+		}
+	}
+}
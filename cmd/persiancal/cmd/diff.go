@@ -13,7 +13,8 @@ var diffCmd = &cobra.Command{
 	Short: "Calculate the difference between two Jalali dates",
 	Long: `Calculate the difference between two dates in the Jalali calendar.
 	
-Both dates should be in Jalali format.
+Dates are usually Jalali, but Gregorian dates are recognised by their year
+and converted first.
 
 Supported input formats:
   - yyyy-MM-dd (e.g., 1404-08-04)
@@ -42,18 +43,16 @@ func init() {
 func runDiff(cmd *cobra.Command, args []string) error {
 	usePersian, _ := cmd.Flags().GetBool("persian")
 
-	date1Str := persiancal.ToLatinDigits(args[0])
-	date2Str := persiancal.ToLatinDigits(args[1])
-
-	j1, err := parseJalaliDate(date1Str)
+	r1, err := parseDate(args[0], nil)
 	if err != nil {
 		return fmt.Errorf("failed to parse first date: %w", err)
 	}
 
-	j2, err := parseJalaliDate(date2Str)
+	r2, err := parseDate(args[1], nil)
 	if err != nil {
 		return fmt.Errorf("failed to parse second date: %w", err)
 	}
+	j1, j2 := r1.Date, r2.Date
 
 	// Calculate differences
 	days := j2.DaysBetween(j1)
@@ -64,8 +63,7 @@ func runDiff(cmd *cobra.Command, args []string) error {
 		if usePersian {
 			output = persiancal.ToPersianDigits(output)
 		}
-		fmt.Println(output)
-		return nil
+		return printDate(cmd, output)
 	}
 
 	if !diffVerbose {
@@ -73,8 +71,7 @@ func runDiff(cmd *cobra.Command, args []string) error {
 		if usePersian {
 			output = persiancal.ToPersianDigits(output)
 		}
-		fmt.Println(output)
-		return nil
+		return printDate(cmd, output)
 	}
 
 	var from, to persiancal.JalaliDate
@@ -86,13 +83,8 @@ func runDiff(cmd *cobra.Command, args []string) error {
 		to = j1
 	}
 
-	years := persiancal.YearsBetween(from, to)
-	from = from.AddYears(years)
-
-	months := persiancal.MonthsBetween(from, to)
-	from = from.AddMonths(months)
-
-	remainingDays := to.DaysBetween(from)
+	period := persiancal.PeriodBetween(from, to)
+	years, months, remainingDays := period.Years, period.Months, period.Days
 
 	var parts []string
 	if years > 0 {
@@ -133,14 +125,12 @@ func runDiff(cmd *cobra.Command, args []string) error {
 		output = persiancal.ToPersianDigits(output)
 	}
 
-	fmt.Printf("%s\n", output)
-	fmt.Printf("(Total: %s)\n", func() string {
-		s := fmt.Sprintf("%d days", absDays)
-		if usePersian {
-			return persiancal.ToPersianDigits(s)
-		}
-		return s
-	}())
-
-	return nil
+	if err := printDate(cmd, output); err != nil {
+		return err
+	}
+	total := fmt.Sprintf("(Total: %d days)", absDays)
+	if usePersian {
+		total = persiancal.ToPersianDigits(total)
+	}
+	return printDate(cmd, total)
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/CHashtager/persiancal/pkg/persiancal"
@@ -11,12 +10,18 @@ import (
 var nowCmd = &cobra.Command{
 	Use:   "now",
 	Short: "Display the current date in Jalali calendar",
-	Long:  `Display the current date and time in the Persian (Jalali) calendar.`,
+	Long: `Display the current date and time in the Persian (Jalali) calendar.
+
+The date is computed in the Asia/Tehran time zone by default, so the result
+does not depend on the time zone of the machine. Use --timezone to pick
+another zone, or "Local" for the system zone.`,
 	Example: `  persiancal now
+  persiancal now --timezone UTC
   persiancal now --format "yyyy/MM/dd"
   persiancal now --format "MMMM dd, yyyy"
+  persiancal now --strftime "%A %d %B %Y %H:%M"
   persiancal now --persian`,
-	Run: runNow,
+	RunE: runNow,
 }
 
 var (
@@ -24,6 +29,8 @@ var (
 	nowShowTime    bool
 	nowLongFormat  bool
 	nowEnglishName bool
+	nowTimezone    string
+	nowStrftime    string
 )
 
 func init() {
@@ -33,16 +40,29 @@ func init() {
 	nowCmd.Flags().BoolVarP(&nowShowTime, "time", "t", false, "Show time along with date")
 	nowCmd.Flags().BoolVarP(&nowLongFormat, "long", "l", false, "Use long format with month name")
 	nowCmd.Flags().BoolVarP(&nowEnglishName, "english", "e", false, "Use English month names (with --long)")
+	nowCmd.Flags().StringVarP(&nowTimezone, "timezone", "z", "Asia/Tehran", "Time zone used to determine the current date")
+	nowCmd.Flags().StringVar(&nowStrftime, "strftime", "", "strftime format with Jalali directives (e.g., '%Y/%m/%d %H:%M')")
+	nowCmd.MarkFlagsMutuallyExclusive("format", "strftime")
 }
 
-func runNow(cmd *cobra.Command, args []string) {
+func runNow(cmd *cobra.Command, args []string) error {
 	usePersian, _ := cmd.Flags().GetBool("persian")
 
-	now := time.Now()
+	loc, err := loadLocation(nowTimezone)
+	if err != nil {
+		return err
+	}
+
+	now := persiancal.CurrentClock().Now().In(loc)
 	j := persiancal.FromGregorianDate(now)
 	var output string
 
-	if nowFormat != "" {
+	if nowStrftime != "" {
+		output = persiancal.Strftime(now, nowStrftime)
+		if usePersian {
+			output = persiancal.ToPersianDigits(output)
+		}
+	} else if nowFormat != "" {
 		if usePersian {
 			output = j.FormatPersian(nowFormat)
 		} else {
@@ -74,5 +94,18 @@ func runNow(cmd *cobra.Command, args []string) {
 		output += " " + timeStr
 	}
 
-	fmt.Println(output)
+	return printDate(cmd, output)
+}
+
+// loadLocation resolves a time zone name, falling back to the library's
+// built-in Tehran zone when the system has no time zone database
+func loadLocation(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err == nil {
+		return loc, nil
+	}
+	if name == "Asia/Tehran" {
+		return persiancal.TehranLocation(), nil
+	}
+	return nil, err
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/CHashtager/persiancal/pkg/persiancal"
 	"github.com/spf13/cobra"
 )
 
@@ -33,4 +34,25 @@ func Execute() {
 
 func init() {
 	rootCmd.PersistentFlags().BoolP("persian", "p", false, "Use Persian digits in output")
+	rootCmd.PersistentFlags().String("bidi", "none", "Wrap output in Unicode directional characters; --bidi=MODE or --bidi MODE, where MODE is none, isolate, rtl, ltr or marks")
+}
+
+// bidiModes maps --bidi values to modes
+var bidiModes = map[string]persiancal.BidiMode{
+	"none":    persiancal.BidiNone,
+	"isolate": persiancal.BidiIsolate,
+	"rtl":     persiancal.BidiIsolateRTL,
+	"ltr":     persiancal.BidiIsolateLTR,
+	"marks":   persiancal.BidiMarks,
+}
+
+// printDate prints a line of output, wrapped as selected by --bidi
+func printDate(cmd *cobra.Command, s string) error {
+	name, _ := cmd.Flags().GetString("bidi")
+	mode, ok := bidiModes[name]
+	if !ok {
+		return fmt.Errorf("invalid --bidi mode %q: must be none, isolate, rtl, ltr or marks", name)
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), mode.Wrap(s))
+	return nil
 }
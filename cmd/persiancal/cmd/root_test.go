@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/CHashtager/persiancal/pkg/persiancal"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// execute runs the CLI with args and returns what it printed. Flags are
+// reset afterwards, since cobra keeps their values between runs.
+func execute(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	defer resetFlags(rootCmd)
+	defer persiancal.SetClock(persiancal.SetClock(persiancal.FixedClock(
+		time.Date(2025, 10, 26, 10, 0, 0, 0, time.UTC))))
+
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(io.Discard)
+	rootCmd.SetArgs(args)
+	err := rootCmd.Execute()
+	return out.String(), err
+}
+
+// resetFlags restores the default value of every flag of c and its
+// subcommands
+func resetFlags(c *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		f.Value.Set(f.DefValue)
+		f.Changed = false
+	}
+	c.PersistentFlags().VisitAll(reset)
+	c.Flags().VisitAll(reset)
+	for _, sub := range c.Commands() {
+		resetFlags(sub)
+	}
+}
+
+func TestBidiFlag(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"now"}, "1404-08-04\n"},
+		{[]string{"now", "--bidi", "rtl"}, "\u20671404-08-04\u2069\n"},
+		{[]string{"now", "--bidi=isolate"}, "\u20681404-08-04\u2069\n"},
+		{[]string{"now", "--bidi=marks", "--persian"}, "\u200f۱۴۰۴-۰۸-۰۴\u200e\n"},
+		{[]string{"convert", "2025-10-26", "--bidi", "rtl"}, "\u20671404-08-04\u2069\n"},
+		{[]string{"convert", "--bidi=ltr", "1404-08-04"}, "\u20662025-10-26\u2069\n"},
+		{[]string{"diff", "1403-01-01", "1404-01-01", "--bidi=isolate"}, "\u2068366 days\u2069\n"},
+		{[]string{"diff", "1403-01-01", "1404-01-01", "--bidi", "ltr", "--days-only"}, "\u2066366\u2069\n"},
+		{
+			[]string{"diff", "1403-01-01", "1404-02-03", "--bidi=isolate", "--verbose"},
+			"\u20681 year, 1 month and 2 days\u2069\n\u2068(Total: 399 days)\u2069\n",
+		},
+	}
+	for _, tt := range tests {
+		got, err := execute(t, tt.args...)
+		if err != nil {
+			t.Errorf("%q: %v", tt.args, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q printed %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestBidiFlagErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"now", "--bidi"},
+		{"now", "--bidi=up"},
+		{"convert", "2025-10-26", "--bidi"},
+	} {
+		if out, err := execute(t, args...); err == nil {
+			t.Errorf("%q printed %q, want an error", args, out)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package persiancal
+
+import (
+	"fmt"
+	"time"
+)
+
+// LeapDayPolicy decides when an anniversary of 30 Esfand is observed in
+// years that are not leap years
+type LeapDayPolicy int
+
+const (
+	// LeapDayEsfand29 observes the anniversary on 29 Esfand, the last day of
+	// the year
+	LeapDayEsfand29 LeapDayPolicy = iota
+
+	// LeapDayFarvardin1 observes the anniversary on 1 Farvardin, the day
+	// after 29 Esfand
+	LeapDayFarvardin1
+)
+
+// String returns the name of the policy
+func (p LeapDayPolicy) String() string {
+	switch p {
+	case LeapDayEsfand29:
+		return "29 Esfand"
+	case LeapDayFarvardin1:
+		return "1 Farvardin"
+	default:
+		return fmt.Sprintf("LeapDayPolicy(%d)", int(p))
+	}
+}
+
+// overflow returns the OverflowPolicy that resolves 30 Esfand the same way
+func (p LeapDayPolicy) overflow() OverflowPolicy {
+	if p == LeapDayFarvardin1 {
+		return OverflowRoll
+	}
+	return OverflowClamp
+}
+
+// Anniversary computes the yearly occurrences of a date, such as a birthday
+// or a wedding anniversary
+//
+//	birth, _ := persiancal.New(1370, 5, 15)
+//	a, _ := persiancal.NewAnniversary(birth, persiancal.LeapDayEsfand29)
+//	today := persiancal.TodayIn(loc)
+//	fmt.Println(a.Age(today), a.DaysUntil(today))
+type Anniversary struct {
+	Date    JalaliDate
+	LeapDay LeapDayPolicy
+}
+
+// Occurrence is a single yearly occurrence of an Anniversary
+type Occurrence struct {
+	Date  JalaliDate
+	Years int // number of years since the original date, 0 for the date itself
+}
+
+// NewAnniversary creates a new Anniversary with validation
+func NewAnniversary(date JalaliDate, policy LeapDayPolicy) (Anniversary, error) {
+	if err := date.Validate(); err != nil {
+		return Anniversary{}, err
+	}
+	return Anniversary{Date: date, LeapDay: policy}, nil
+}
+
+// In returns the occurrence that belongs to the given Jalali year. With
+// LeapDayFarvardin1 the occurrence of 30 Esfand in a common year falls on
+// 1 Farvardin of the following year.
+func (a Anniversary) In(year int) Occurrence {
+	j, _ := MonthDayOf(a.Date).InYear(year, a.LeapDay.overflow())
+	return Occurrence{Date: j, Years: year - a.Date.Year}
+}
+
+// Next returns the first occurrence on or after ref. On the anniversary
+// itself, the occurrence on ref is returned.
+func (a Anniversary) Next(ref JalaliDate) Occurrence {
+	// An occurrence rolled into Farvardin belongs to the previous year
+	year := max(ref.Year-1, a.Date.Year)
+	for {
+		occ := a.In(year)
+		if !occ.Date.Before(ref) {
+			return occ
+		}
+		year++
+	}
+}
+
+// Previous returns the last occurrence strictly before ref. ok is false if
+// ref is on or before the original date.
+func (a Anniversary) Previous(ref JalaliDate) (occ Occurrence, ok bool) {
+	for year := ref.Year; year >= a.Date.Year; year-- {
+		if occ = a.In(year); occ.Date.Before(ref) {
+			return occ, true
+		}
+	}
+	return Occurrence{}, false
+}
+
+// Age returns the exact time elapsed from the original date to ref. Whole
+// years are counted by occurrences, so with LeapDayEsfand29 someone born on
+// 30 Esfand turns one on 29 Esfand of the following year.
+func (a Anniversary) Age(ref JalaliDate) Period {
+	if ref.Before(a.Date) {
+		return PeriodBetween(a.Date, ref)
+	}
+	last := a.Next(ref)
+	if last.Date.After(ref) {
+		last, _ = a.Previous(ref)
+	}
+	p := PeriodBetween(last.Date, ref)
+	if p.Years > 0 {
+		// From an occurrence on 29 Esfand to 29 Esfand of a leap year is a
+		// calendar year, but the next occurrence is on the 30th
+		p = Period{Months: 11, Days: ref.DaysBetween(last.Date.AddMonths(11))}
+	}
+	p.Years += last.Years
+	return p
+}
+
+// DaysUntil returns the number of days from ref to the next occurrence,
+// which is zero on the anniversary itself
+func (a Anniversary) DaysUntil(ref JalaliDate) int {
+	return a.Next(ref).Date.DaysBetween(ref)
+}
+
+// NextGregorian returns the first Gregorian anniversary of the original
+// date on or after ref, for people who celebrate by the Gregorian calendar.
+// Years counts Gregorian years. An original date of 29 February follows the
+// leap-day policy: LeapDayEsfand29 observes it on 28 February and
+// LeapDayFarvardin1 on 1 March in common years.
+func (a Anniversary) NextGregorian(ref JalaliDate) Occurrence {
+	first := a.Date.ToGregorian().Year()
+	for year := max(ref.ToGregorian().Year()-1, first); ; year++ {
+		occ := a.gregorianIn(year)
+		if !occ.Date.Before(ref) {
+			return occ
+		}
+	}
+}
+
+// gregorianIn returns the Gregorian anniversary in the given Gregorian year
+func (a Anniversary) gregorianIn(year int) Occurrence {
+	first, month, day := a.Date.ToGregorian().Date()
+	if month == time.February && day == 29 && !isGregorianLeap(year) {
+		if a.LeapDay == LeapDayFarvardin1 {
+			month, day = time.March, 1
+		} else {
+			day = 28
+		}
+	}
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return Occurrence{Date: FromGregorianDate(t), Years: year - first}
+}
+
+// NextDual answers a different question from Next and NextGregorian: in
+// which year do the Jalali and the Gregorian anniversary fall on the same
+// day? It returns the first Jalali occurrence on or after ref whose
+// Gregorian date has the month and day of the original date. Because the
+// two calendars drift by up to a day against each other, such years come
+// in runs with gaps between them. ok is false if there is no such
+// occurrence up to MaxYear.
+func (a Anniversary) NextDual(ref JalaliDate) (occ Occurrence, ok bool) {
+	_, month, day := a.Date.ToGregorian().Date()
+	for occ = a.Next(ref); occ.Date.Year <= MaxYear; occ = a.In(a.Date.Year + occ.Years + 1) {
+		_, m, d := occ.Date.ToGregorian().Date()
+		if m == month && d == day {
+			return occ, true
+		}
+	}
+	return Occurrence{}, false
+}
+
+// Gregorian returns the Gregorian date of the occurrence at midnight UTC
+func (o Occurrence) Gregorian() time.Time {
+	return o.Date.ToGregorian()
+}
@@ -0,0 +1,149 @@
+package persiancal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAnniversaryNext(t *testing.T) {
+	// 1370/05/15 is 6 August 1991. In 1403 and 1407 the Jalali birthday
+	// falls on 5 August, a day before the Gregorian one.
+	a, err := NewAnniversary(JalaliDate{1370, 5, 15}, LeapDayEsfand29)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		ref       JalaliDate
+		next      JalaliDate
+		gregorian string
+		dual      JalaliDate
+	}{
+		{JalaliDate{1402, 5, 15}, JalaliDate{1402, 5, 15}, "2023-08-06", JalaliDate{1402, 5, 15}},
+		{JalaliDate{1403, 1, 1}, JalaliDate{1403, 5, 15}, "2024-08-06", JalaliDate{1404, 5, 15}},
+		{JalaliDate{1404, 8, 4}, JalaliDate{1405, 5, 15}, "2026-08-06", JalaliDate{1405, 5, 15}},
+		{JalaliDate{1407, 1, 1}, JalaliDate{1407, 5, 15}, "2028-08-06", JalaliDate{1409, 5, 15}},
+	}
+	for _, tt := range tests {
+		if got := a.Next(tt.ref).Date; got != tt.next {
+			t.Errorf("Next(%s) = %s, want %s", tt.ref, got, tt.next)
+		}
+		g := a.NextGregorian(tt.ref)
+		if got := g.Gregorian().Format(time.DateOnly); got != tt.gregorian {
+			t.Errorf("NextGregorian(%s) = %s, want %s", tt.ref, got, tt.gregorian)
+		}
+		if want := g.Gregorian().Year() - 1991; g.Years != want {
+			t.Errorf("NextGregorian(%s).Years = %d, want %d", tt.ref, g.Years, want)
+		}
+		dual, ok := a.NextDual(tt.ref)
+		if !ok || dual.Date != tt.dual {
+			t.Errorf("NextDual(%s) = %s, %v; want %s", tt.ref, dual.Date, ok, tt.dual)
+		}
+	}
+}
+
+func TestAnniversaryNextGregorianNewYear(t *testing.T) {
+	// 1362/10/11 is 1 January 1984; in 1403 its Jalali anniversary falls on
+	// 31 December 2024, so the Gregorian one is 1403/10/12
+	a := Anniversary{Date: JalaliDate{1362, 10, 11}}
+	occ := a.NextGregorian(JalaliDate{1403, 10, 11})
+	if occ.Date != (JalaliDate{1403, 10, 12}) || occ.Years != 41 {
+		t.Errorf("NextGregorian = %+v, want 1403/10/12 after 41 years", occ)
+	}
+}
+
+func TestAnniversaryLeapDays(t *testing.T) {
+	// 1378/12/10 is 29 February 2000; 1403/12/30 only exists in leap years
+	feb29 := JalaliDate{1378, 12, 10}
+	esfand30 := JalaliDate{1403, 12, 30}
+	tests := []struct {
+		policy    LeapDayPolicy
+		gregorian string     // NextGregorian of feb29 from 1404/01/01
+		jalali    JalaliDate // In(1404) of esfand30
+	}{
+		{LeapDayEsfand29, "2026-02-28", JalaliDate{1404, 12, 29}},
+		{LeapDayFarvardin1, "2026-03-01", JalaliDate{1405, 1, 1}},
+	}
+	for _, tt := range tests {
+		a := Anniversary{Date: feb29, LeapDay: tt.policy}
+		if got := a.NextGregorian(JalaliDate{1404, 1, 1}).Gregorian().Format(time.DateOnly); got != tt.gregorian {
+			t.Errorf("%s: NextGregorian = %s, want %s", tt.policy, got, tt.gregorian)
+		}
+		if got := a.NextGregorian(JalaliDate{1402, 1, 1}).Gregorian().Format(time.DateOnly); got != "2024-02-29" {
+			t.Errorf("%s: NextGregorian in a leap year = %s, want 2024-02-29", tt.policy, got)
+		}
+
+		b := Anniversary{Date: esfand30, LeapDay: tt.policy}
+		if got := b.In(1404); got.Date != tt.jalali || got.Years != 1 {
+			t.Errorf("%s: In(1404) = %+v, want %s after 1 year", tt.policy, got, tt.jalali)
+		}
+		if got := b.Next(JalaliDate{1404, 12, 29}).Date; got != tt.jalali {
+			t.Errorf("%s: Next(1404/12/29) = %s, want %s", tt.policy, got, tt.jalali)
+		}
+	}
+}
+
+func TestAnniversaryEsfand30(t *testing.T) {
+	// Born on 30 Esfand 1403. 1404 to 1407 are common years and 1408 is a
+	// leap year, so the 5th anniversary is on 30 Esfand again.
+	tests := []struct {
+		ref       JalaliDate
+		policy    LeapDayPolicy
+		age       Period
+		previous  JalaliDate // zero if there is none
+		daysUntil int
+	}{
+		{JalaliDate{1403, 12, 30}, LeapDayEsfand29, Period{}, JalaliDate{}, 0},
+		{JalaliDate{1404, 1, 1}, LeapDayEsfand29, Period{Days: 1}, JalaliDate{1403, 12, 30}, 364},
+		{JalaliDate{1404, 12, 28}, LeapDayEsfand29, Period{Months: 11, Days: 28}, JalaliDate{1403, 12, 30}, 1},
+		{JalaliDate{1404, 12, 29}, LeapDayEsfand29, Period{Years: 1}, JalaliDate{1403, 12, 30}, 0},
+		{JalaliDate{1405, 1, 1}, LeapDayEsfand29, Period{Years: 1, Days: 1}, JalaliDate{1404, 12, 29}, 364},
+		{JalaliDate{1407, 12, 29}, LeapDayEsfand29, Period{Years: 4}, JalaliDate{1406, 12, 29}, 0},
+		{JalaliDate{1408, 12, 29}, LeapDayEsfand29, Period{Years: 4, Months: 11, Days: 30}, JalaliDate{1407, 12, 29}, 1},
+		{JalaliDate{1408, 12, 30}, LeapDayEsfand29, Period{Years: 5}, JalaliDate{1407, 12, 29}, 0},
+		{JalaliDate{1409, 12, 29}, LeapDayEsfand29, Period{Years: 6}, JalaliDate{1408, 12, 30}, 0},
+
+		{JalaliDate{1403, 12, 30}, LeapDayFarvardin1, Period{}, JalaliDate{}, 0},
+		{JalaliDate{1404, 12, 29}, LeapDayFarvardin1, Period{Months: 11, Days: 29}, JalaliDate{1403, 12, 30}, 1},
+		{JalaliDate{1405, 1, 1}, LeapDayFarvardin1, Period{Years: 1}, JalaliDate{1403, 12, 30}, 0},
+		{JalaliDate{1405, 1, 2}, LeapDayFarvardin1, Period{Years: 1, Days: 1}, JalaliDate{1405, 1, 1}, 364},
+		{JalaliDate{1407, 12, 29}, LeapDayFarvardin1, Period{Years: 3, Months: 11, Days: 28}, JalaliDate{1407, 1, 1}, 1},
+		{JalaliDate{1408, 1, 1}, LeapDayFarvardin1, Period{Years: 4}, JalaliDate{1407, 1, 1}, 0},
+		{JalaliDate{1408, 12, 29}, LeapDayFarvardin1, Period{Years: 4, Months: 11, Days: 28}, JalaliDate{1408, 1, 1}, 1},
+		{JalaliDate{1408, 12, 30}, LeapDayFarvardin1, Period{Years: 5}, JalaliDate{1408, 1, 1}, 0},
+		{JalaliDate{1409, 12, 29}, LeapDayFarvardin1, Period{Years: 5, Months: 11, Days: 29}, JalaliDate{1408, 12, 30}, 1},
+		{JalaliDate{1410, 1, 1}, LeapDayFarvardin1, Period{Years: 6}, JalaliDate{1408, 12, 30}, 0},
+	}
+	for _, tt := range tests {
+		a := Anniversary{Date: JalaliDate{1403, 12, 30}, LeapDay: tt.policy}
+		if got := a.Age(tt.ref); got != tt.age {
+			t.Errorf("%s: Age(%s) = %s, want %s", tt.policy, tt.ref, got, tt.age)
+		}
+		prev, ok := a.Previous(tt.ref)
+		if ok != (tt.previous != JalaliDate{}) || prev.Date != tt.previous {
+			t.Errorf("%s: Previous(%s) = %s, %v; want %s", tt.policy, tt.ref, prev.Date, ok, tt.previous)
+		}
+		if got := a.DaysUntil(tt.ref); got != tt.daysUntil {
+			t.Errorf("%s: DaysUntil(%s) = %d, want %d", tt.policy, tt.ref, got, tt.daysUntil)
+		}
+	}
+}
+
+func TestAnniversaryAgeEveryDay(t *testing.T) {
+	// Age never reaches a year before the next occurrence, and is a whole
+	// number of years on every occurrence
+	for _, date := range []JalaliDate{{1403, 12, 30}, {1404, 12, 29}, {1370, 5, 15}, {1399, 6, 31}} {
+		for _, policy := range []LeapDayPolicy{LeapDayEsfand29, LeapDayFarvardin1} {
+			a := Anniversary{Date: date, LeapDay: policy}
+			for ref := date; ref.Year < 1420; ref = ref.AddDays(1) {
+				age, next := a.Age(ref), a.Next(ref)
+				if next.Date == ref {
+					if age != (Period{Years: next.Years}) {
+						t.Fatalf("%s from %s: Age(%s) = %s on occurrence %d", policy, date, ref, age, next.Years)
+					}
+				} else if age.Years != next.Years-1 {
+					t.Fatalf("%s from %s: Age(%s) = %s before occurrence %d on %s", policy, date, ref, age, next.Years, next.Date)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package persiancal
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// BadiDate represents a date in the Badí' calendar. A year has 19 months of
+// 19 days and starts on Naw-Rúz, the day in Tehran on which the March
+// equinox falls before sunset. That is 1 Farvardin, or the day before it
+// when the equinox falls between noon and sunset, as in 2025. The four or
+// five intercalary days of Ayyám-i-Há fall between the 18th and 19th months
+// and are represented as month 0.
+type BadiDate struct {
+	Year  int // years of the Badí' era, 182 starting in March 2025
+	Month int // 1-19, or 0 for Ayyám-i-Há
+	Day   int // 1-19, or 1-5 for Ayyám-i-Há
+}
+
+// Constants for the Badí' calendar
+const (
+	badiYearOffset = 1222 // Jalali year minus Badí' year
+	ayyamiHaMonth  = 0    // month number used for Ayyám-i-Há
+	badiMonthDays  = 19
+	badiHaStart    = 18 * badiMonthDays // day of year (from 0) where Ayyám-i-Há starts
+
+	// tehranSunset is the time of sunset in Tehran at the March equinox,
+	// about 18:15 Iran Standard Time, as a fraction of the day
+	tehranSunset = 18.25 / 24
+	tehranOffset = 3.5 / 24 // Iran Standard Time, UTC+3:30, in days
+)
+
+// Badí' month names (1-indexed, 0 is Ayyám-i-Há). The days of each month
+// carry the same names.
+var badiMonthNames = []MonthName{
+	{Persian: "ایام‌ها", English: "Ayyám-i-Há"}, // 0
+	{Persian: "بهاء", English: "Bahá"},          // 1
+	{Persian: "جلال", English: "Jalál"},         // 2
+	{Persian: "جمال", English: "Jamál"},         // 3
+	{Persian: "عظمت", English: "ʻAẓamat"},       // 4
+	{Persian: "نور", English: "Núr"},            // 5
+	{Persian: "رحمت", English: "Raḥmat"},        // 6
+	{Persian: "کلمات", English: "Kalimát"},      // 7
+	{Persian: "کمال", English: "Kamál"},         // 8
+	{Persian: "اسماء", English: "Asmáʼ"},        // 9
+	{Persian: "عزت", English: "ʻIzzat"},         // 10
+	{Persian: "مشیت", English: "Mashíyyat"},     // 11
+	{Persian: "علم", English: "ʻIlm"},           // 12
+	{Persian: "قدرت", English: "Qudrat"},        // 13
+	{Persian: "قول", English: "Qawl"},           // 14
+	{Persian: "مسائل", English: "Masáʼil"},      // 15
+	{Persian: "شرف", English: "Sharaf"},         // 16
+	{Persian: "سلطان", English: "Sulṭán"},       // 17
+	{Persian: "ملک", English: "Mulk"},           // 18
+	{Persian: "علاء", English: "ʻAláʼ"},         // 19
+}
+
+// Badí' weekday names, indexed by time.Weekday
+var badiWeekdayNames = []MonthName{
+	{Persian: "جمال", English: "Jamál"},       // Sunday
+	{Persian: "کمال", English: "Kamál"},       // Monday
+	{Persian: "فضال", English: "Fiḍál"},       // Tuesday
+	{Persian: "عدال", English: "ʻIdál"},       // Wednesday
+	{Persian: "استجلال", English: "Istijlál"}, // Thursday
+	{Persian: "استقلال", English: "Istiqlál"}, // Friday
+	{Persian: "جلال", English: "Jalál"},       // Saturday
+}
+
+// badiTokens are the layout tokens understood by BadiDate.Format
+var badiTokens = []string{"yyyy", "yy", "MMMM", "MMM", "MM", "M", "dddd", "ddd", "dd", "d"}
+
+// NewBadi creates a new BadiDate with validation
+func NewBadi(year, month, day int) (BadiDate, error) {
+	b := BadiDate{Year: year, Month: month, Day: day}
+	if err := b.Validate(); err != nil {
+		return BadiDate{}, err
+	}
+	return b, nil
+}
+
+// BadiOf returns the Badí' date of j
+func BadiOf(j JalaliDate) BadiDate {
+	y, m, d := jdnToBadi(jalaliToJDN(j.Year, j.Month, j.Day))
+	return BadiDate{Year: y, Month: m, Day: d}
+}
+
+// BadiFromGregorian returns the Badí' date of the calendar day of t in t's
+// location
+func BadiFromGregorian(t time.Time) BadiDate {
+	y, m, d := jdnToBadi(int(DayNumberOf(t)))
+	return BadiDate{Year: y, Month: m, Day: d}
+}
+
+// Validate checks if the BadiDate is valid
+func (b BadiDate) Validate() error {
+	if jy := b.Year + badiYearOffset; jy < MinYear || jy > MaxYear {
+		return ErrOutOfRange
+	}
+	if b.Month < 0 || b.Month > 19 {
+		return fmt.Errorf("%w: Badí' month must be between 0 and 19", ErrInvalidDate)
+	}
+	if b.Day < 1 || b.Day > b.DaysInMonth() {
+		return ErrInvalidDay
+	}
+	return nil
+}
+
+// DaysInMonth returns the number of days in the month: 19, or 4 or 5 for
+// Ayyám-i-Há
+func (b BadiDate) DaysInMonth() int {
+	if b.Month == ayyamiHaMonth {
+		return ayyamiHaDays(b.Year)
+	}
+	return badiMonthDays
+}
+
+// IsAyyamiHa reports whether the date is one of the intercalary days
+func (b BadiDate) IsAyyamiHa() bool {
+	return b.Month == ayyamiHaMonth
+}
+
+// DayNumber returns the Julian Day Number of the date.
+// Returns an error if the date is invalid or outside the supported range.
+func (b BadiDate) DayNumber() (DayNumber, error) {
+	if err := b.Validate(); err != nil {
+		return 0, err
+	}
+	return DayNumber(badiToJDN(b.Year, b.Month, b.Day)), nil
+}
+
+// JalaliDate converts the date to the Jalali calendar.
+// Returns an error if the date is invalid or outside the supported range.
+func (b BadiDate) JalaliDate() (JalaliDate, error) {
+	n, err := b.DayNumber()
+	if err != nil {
+		return JalaliDate{}, err
+	}
+	return n.Jalali()
+}
+
+// ToGregorian converts the date to a Gregorian time.Time at midnight UTC
+func (b BadiDate) ToGregorian() time.Time {
+	return DayNumber(badiToJDN(b.Year, b.Month, b.Day)).Time(time.UTC)
+}
+
+// Weekday returns the day of the week of the date
+func (b BadiDate) Weekday() time.Weekday {
+	return DayNumber(badiToJDN(b.Year, b.Month, b.Day)).Weekday()
+}
+
+// MonthName returns the Persian name of the month
+func (b BadiDate) MonthName() string {
+	return badiName(b.Month).Persian
+}
+
+// MonthNameEnglish returns the transliterated name of the month
+func (b BadiDate) MonthNameEnglish() string {
+	return badiName(b.Month).English
+}
+
+// DayName returns the Persian name of the day of the month. Days of
+// Ayyám-i-Há have no names and return "".
+func (b BadiDate) DayName() string {
+	if b.IsAyyamiHa() {
+		return ""
+	}
+	return badiName(b.Day).Persian
+}
+
+// DayNameEnglish returns the transliterated name of the day of the month.
+// Days of Ayyám-i-Há have no names and return "".
+func (b BadiDate) DayNameEnglish() string {
+	if b.IsAyyamiHa() {
+		return ""
+	}
+	return badiName(b.Day).English
+}
+
+// WeekdayName returns the Persian name of the Badí' weekday, e.g. جلال for
+// Saturday
+func (b BadiDate) WeekdayName() string {
+	return badiWeekdayNames[b.Weekday()].Persian
+}
+
+// WeekdayNameEnglish returns the transliterated name of the Badí' weekday
+func (b BadiDate) WeekdayNameEnglish() string {
+	return badiWeekdayNames[b.Weekday()].English
+}
+
+// String returns a string representation in yyyy/MM/dd format
+func (b BadiDate) String() string {
+	return fmt.Sprintf("%04d/%02d/%02d", b.Year, b.Month, b.Day)
+}
+
+// Format formats the BadiDate according to the given layout.
+// Supported tokens are those of JalaliDate.Format, with Badí' month names,
+// plus:
+//   - dddd: Persian day name (e.g., بهاء)
+//   - ddd: transliterated day name (e.g., Bahá)
+func (b BadiDate) Format(layout string) string {
+	return formatLayout(layout, badiTokens, func(token string) string {
+		switch token {
+		case "MMMM":
+			return b.MonthName()
+		case "MMM":
+			return b.MonthNameEnglish()
+		case "dddd":
+			return b.DayName()
+		case "ddd":
+			return b.DayNameEnglish()
+		}
+		return formatField(token, b.Year, b.Month, b.Day)
+	})
+}
+
+// badiName returns the names of a month or day, where 0 is Ayyám-i-Há
+func badiName(i int) MonthName {
+	if i < 0 || i >= len(badiMonthNames) {
+		return MonthName{}
+	}
+	return badiMonthNames[i]
+}
+
+// ayyamiHaDays returns the number of intercalary days in a Badí' year: the
+// days left between one Naw-Rúz and the next after the 19 months
+func ayyamiHaDays(year int) int {
+	return badiNawRuz(year+1) - badiNawRuz(year) - 19*badiMonthDays
+}
+
+// badiNawRuz returns the Julian Day Number of Naw-Rúz of a Badí' year. It
+// starts from 1 Farvardin, whose cut-off for the equinox is noon, and moves
+// a day earlier when the equinox falls between noon and sunset. Outside
+// 1000-3000 AD, where marchEquinox is not accurate, it is 1 Farvardin.
+func badiNawRuz(year int) int {
+	jy := year + badiYearOffset
+	nowruz := jalaliToJDN(jy, 1, 1)
+	gy := jy + 621
+	if gy < 1000 || gy > 3000 {
+		return nowruz
+	}
+	local := marchEquinox(gy) + tehranOffset + 0.5
+	day := math.Floor(local)
+	if int(day) == nowruz-1 && local-day < tehranSunset {
+		return nowruz - 1
+	}
+	return nowruz
+}
+
+// marchEquinox returns the moment of the March equinox of a Gregorian year
+// as a Julian Date in UT, accurate to about a minute for 1000-3000 AD. It
+// follows Meeus, Astronomical Algorithms, chapter 27.
+func marchEquinox(year int) float64 {
+	y := float64(year-2000) / 1000
+	jde0 := 2451623.80984 + y*(365242.37404+y*(0.05169+y*(-0.00411+y*-0.00057)))
+	t := (jde0 - 2451545) / 36525
+	w := (35999.373*t - 2.47) * math.Pi / 180
+	dl := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+	var s float64
+	for _, p := range equinoxTerms {
+		s += p[0] * math.Cos((p[1]+p[2]*t)*math.Pi/180)
+	}
+	jde := jde0 + 0.00001*s/dl
+	return jde - deltaT(year)/86400
+}
+
+// equinoxTerms are the periodic terms A, B and C of Meeus, table 27.C
+var equinoxTerms = [][3]float64{
+	{485, 324.96, 1934.136}, {203, 337.23, 32964.467}, {199, 342.08, 20.186},
+	{182, 27.85, 445267.112}, {156, 73.14, 45036.886}, {136, 171.52, 22518.443},
+	{77, 222.54, 65928.934}, {74, 296.72, 3034.906}, {70, 243.58, 9037.513},
+	{58, 119.81, 33718.147}, {52, 297.17, 150.678}, {50, 21.02, 2281.226},
+	{45, 247.54, 29929.562}, {44, 325.15, 31555.956}, {29, 60.93, 4443.417},
+	{18, 155.12, 67555.328}, {17, 288.79, 4562.452}, {16, 198.04, 62894.029},
+	{14, 199.76, 31436.921}, {12, 95.39, 14577.848}, {12, 287.11, 31931.756},
+	{12, 320.81, 34777.259}, {9, 227.73, 1222.114}, {8, 15.45, 16859.074},
+}
+
+// deltaT returns the difference between Terrestrial Time and UT in seconds
+// for a Gregorian year, using the polynomials of Espenak and Meeus
+func deltaT(year int) float64 {
+	y := float64(year)
+	switch {
+	case year >= 1961 && year < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case year >= 2050 && year < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
+
+// badiToJDN converts a Badí' date to Julian Day Number
+func badiToJDN(y, m, d int) int {
+	nawruz := badiNawRuz(y)
+	switch m {
+	case ayyamiHaMonth:
+		return nawruz + badiHaStart + d - 1
+	case 19:
+		return nawruz + badiHaStart + ayyamiHaDays(y) + d - 1
+	default:
+		return nawruz + (m-1)*badiMonthDays + d - 1
+	}
+}
+
+// jdnToBadi converts a Julian Day Number to a Badí' date
+func jdnToBadi(jdn int) (y, m, d int) {
+	jy, _, _ := jdnToJalali(jdn)
+	y = jy - badiYearOffset
+	if jdn >= badiNawRuz(y+1) {
+		// the day before 1 Farvardin that starts the next Badí' year
+		y++
+	}
+	doy := jdn - badiNawRuz(y)
+
+	ha := ayyamiHaDays(y)
+	switch {
+	case doy < badiHaStart:
+		return y, doy/badiMonthDays + 1, doy%badiMonthDays + 1
+	case doy < badiHaStart+ha:
+		return y, ayyamiHaMonth, doy - badiHaStart + 1
+	default:
+		return y, 19, doy - badiHaStart - ha + 1
+	}
+}
@@ -0,0 +1,184 @@
+package persiancal
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBadiNawRuz(t *testing.T) {
+	// Naw-Rúz as published by the Bahá'í World Centre. It is 1 Farvardin,
+	// or the day before when the equinox falls between noon and sunset in
+	// Tehran, as in 174, 178 and 182.
+	tests := []struct {
+		year int
+		want string
+	}{
+		{172, "2015-03-21"},
+		{173, "2016-03-20"},
+		{174, "2017-03-20"},
+		{175, "2018-03-21"},
+		{178, "2021-03-20"},
+		{179, "2022-03-21"},
+		{181, "2024-03-20"},
+		{182, "2025-03-20"},
+		// The equinox is at about 18:16 in Tehran, just after sunset
+		{183, "2026-03-21"},
+		{184, "2027-03-21"},
+		{185, "2028-03-20"},
+	}
+	for _, tt := range tests {
+		b := BadiDate{Year: tt.year, Month: 1, Day: 1}
+		if got := b.ToGregorian().Format(time.DateOnly); got != tt.want {
+			t.Errorf("Naw-Rúz %d = %s, want %s", tt.year, got, tt.want)
+		}
+		// and the day before is the last day of ʻAláʼ
+		g, _ := time.Parse(time.DateOnly, tt.want)
+		want := BadiDate{Year: tt.year - 1, Month: 19, Day: 19}
+		if got := BadiFromGregorian(g.AddDate(0, 0, -1)); got != want {
+			t.Errorf("BadiFromGregorian(day before %s) = %v, want %v", tt.want, got, want)
+		}
+	}
+}
+
+func TestBadiAyyamiHa(t *testing.T) {
+	// Published days of Ayyám-i-Há
+	tests := []struct {
+		year        int
+		days        int
+		first, last string
+	}{
+		{174, 5, "2018-02-25", "2018-03-01"},
+		{175, 4, "2019-02-26", "2019-03-01"},
+		{180, 4, "2024-02-26", "2024-02-29"},
+		{181, 4, "2025-02-25", "2025-02-28"},
+		{182, 5, "2026-02-25", "2026-03-01"},
+		{183, 4, "2027-02-26", "2027-03-01"},
+	}
+	for _, tt := range tests {
+		first := BadiDate{Year: tt.year, Month: 0, Day: 1}
+		if n := first.DaysInMonth(); n != tt.days {
+			t.Errorf("Ayyám-i-Há %d has %d days, want %d", tt.year, n, tt.days)
+		}
+		if got := first.ToGregorian().Format(time.DateOnly); got != tt.first {
+			t.Errorf("first day of Ayyám-i-Há %d = %s, want %s", tt.year, got, tt.first)
+		}
+		last := BadiDate{Year: tt.year, Month: 0, Day: tt.days}
+		if got := last.ToGregorian().Format(time.DateOnly); got != tt.last {
+			t.Errorf("last day of Ayyám-i-Há %d = %s, want %s", tt.year, got, tt.last)
+		}
+		if err := (BadiDate{Year: tt.year, Month: 0, Day: tt.days + 1}).Validate(); !errors.Is(err, ErrInvalidDay) {
+			t.Errorf("Ayyám-i-Há %d day %d error = %v, want ErrInvalidDay", tt.year, tt.days+1, err)
+		}
+	}
+}
+
+func TestBadiOf(t *testing.T) {
+	tests := []struct {
+		j    JalaliDate
+		want BadiDate
+	}{
+		{JalaliDate{1404, 8, 4}, BadiDate{182, 12, 12}},
+		// 182 started the day before 1 Farvardin 1404
+		{JalaliDate{1403, 12, 30}, BadiDate{182, 1, 1}},
+		{JalaliDate{1403, 12, 29}, BadiDate{181, 19, 19}},
+		{JalaliDate{1404, 1, 1}, BadiDate{182, 1, 2}},
+		{JalaliDate{1404, 12, 6}, BadiDate{182, 0, 1}},
+		{JalaliDate{1404, 12, 11}, BadiDate{182, 19, 1}},
+		{JalaliDate{1405, 1, 1}, BadiDate{183, 1, 1}},
+	}
+	for _, tt := range tests {
+		got := BadiOf(tt.j)
+		if got != tt.want {
+			t.Errorf("BadiOf(%s) = %v, want %v", tt.j, got, tt.want)
+		}
+		if back, err := tt.want.JalaliDate(); err != nil || back != tt.j {
+			t.Errorf("%v.JalaliDate() = %s, %v; want %s", tt.want, back, err, tt.j)
+		}
+	}
+}
+
+func TestBadiRoundTrip(t *testing.T) {
+	// Every day from 1844 to 2100 converts to a valid Badí' date and back,
+	// and consecutive days are consecutive dates
+	first := DayNumberOf(time.Date(1844, 3, 1, 0, 0, 0, 0, time.UTC))
+	last := DayNumberOf(time.Date(2100, 12, 31, 0, 0, 0, 0, time.UTC))
+	prev := jdnToBadiDate(int(first) - 1)
+	for n := first; n <= last; n++ {
+		b := jdnToBadiDate(int(n))
+		if got, err := b.DayNumber(); err != nil || got != n {
+			t.Fatalf("day %d is %v, which reads back as %d, %v", n, b, got, err)
+		}
+		if !badiFollows(b, prev) {
+			t.Fatalf("%v follows %v", b, prev)
+		}
+		prev = b
+	}
+}
+
+// jdnToBadiDate is jdnToBadi returning a BadiDate
+func jdnToBadiDate(jdn int) BadiDate {
+	y, m, d := jdnToBadi(jdn)
+	return BadiDate{Year: y, Month: m, Day: d}
+}
+
+// badiFollows reports whether b is the day after prev
+func badiFollows(b, prev BadiDate) bool {
+	if prev.Day < prev.DaysInMonth() {
+		return b == BadiDate{prev.Year, prev.Month, prev.Day + 1}
+	}
+	switch prev.Month {
+	case 18:
+		return b == BadiDate{prev.Year, 0, 1}
+	case 0:
+		return b == BadiDate{prev.Year, 19, 1}
+	case 19:
+		return b == BadiDate{prev.Year + 1, 1, 1}
+	default:
+		return b == BadiDate{prev.Year, prev.Month + 1, 1}
+	}
+}
+
+func TestBadiFormat(t *testing.T) {
+	// 1404/08/04 is a Sunday
+	b := BadiDate{182, 12, 12}
+	tests := []struct {
+		layout, want string
+	}{
+		{"yyyy/MM/dd", "0182/12/12"},
+		{"ddd, d MMM yyyy", "ʻIlm, 12 ʻIlm 0182"},
+		{"dddd d MMMM", "علم 12 علم"},
+	}
+	for _, tt := range tests {
+		if got := b.Format(tt.layout); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+	}
+	if got := b.WeekdayNameEnglish(); got != "Jamál" {
+		t.Errorf("WeekdayNameEnglish() = %q, want Jamál", got)
+	}
+
+	ha := BadiDate{182, 0, 3}
+	if !ha.IsAyyamiHa() || ha.MonthNameEnglish() != "Ayyám-i-Há" || ha.DayName() != "" {
+		t.Errorf("%v: IsAyyamiHa %v, month %q, day name %q", ha, ha.IsAyyamiHa(), ha.MonthNameEnglish(), ha.DayName())
+	}
+}
+
+func TestNewBadiErrors(t *testing.T) {
+	tests := []struct {
+		y, m, d int
+		want    error
+	}{
+		{182, 20, 1, ErrInvalidDate},
+		{182, -1, 1, ErrInvalidDate},
+		{182, 1, 20, ErrInvalidDay},
+		{182, 1, 0, ErrInvalidDay},
+		{181, 0, 5, ErrInvalidDay},
+		{MaxYear, 1, 1, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		if _, err := NewBadi(tt.y, tt.m, tt.d); !errors.Is(err, tt.want) {
+			t.Errorf("NewBadi(%d, %d, %d) error = %v, want %v", tt.y, tt.m, tt.d, err, tt.want)
+		}
+	}
+}
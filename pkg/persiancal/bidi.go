@@ -0,0 +1,85 @@
+package persiancal
+
+import "fmt"
+
+// Unicode bidirectional formatting characters
+const (
+	lrm = "\u200e" // left-to-right mark
+	rlm = "\u200f" // right-to-left mark
+	lri = "\u2066" // left-to-right isolate
+	rli = "\u2067" // right-to-left isolate
+	fsi = "\u2068" // first strong isolate
+	pdi = "\u2069" // pop directional isolate
+)
+
+// BidiMode selects how formatted dates are protected from the Unicode
+// bidirectional algorithm when embedded in text of the other direction,
+// such as Persian dates in LTR log lines, HTML or terminal tables
+type BidiMode int
+
+const (
+	// BidiNone leaves the text unchanged
+	BidiNone BidiMode = iota
+
+	// BidiIsolate wraps the text in FSI…PDI, which takes its direction
+	// from the first strong character
+	BidiIsolate
+
+	// BidiIsolateRTL wraps the text in RLI…PDI
+	BidiIsolateRTL
+
+	// BidiIsolateLTR wraps the text in LRI…PDI
+	BidiIsolateLTR
+
+	// BidiMarks puts an RLM before and an LRM after the text, for LTR
+	// output read by software that does not support isolates
+	BidiMarks
+)
+
+// String returns the name of the mode
+func (m BidiMode) String() string {
+	switch m {
+	case BidiNone:
+		return "none"
+	case BidiIsolate:
+		return "isolate"
+	case BidiIsolateRTL:
+		return "rtl"
+	case BidiIsolateLTR:
+		return "ltr"
+	case BidiMarks:
+		return "marks"
+	default:
+		return fmt.Sprintf("BidiMode(%d)", int(m))
+	}
+}
+
+// Wrap wraps s in the directional characters of the mode. Empty strings are
+// returned unchanged.
+//
+//	persiancal.BidiIsolate.Wrap(j.FormatPersian(persiancal.LayoutLong))
+//	// "\u2068۰۴ آبان ۱۴۰۴\u2069"
+func (m BidiMode) Wrap(s string) string {
+	if s == "" {
+		return s
+	}
+	switch m {
+	case BidiIsolate:
+		return fsi + s + pdi
+	case BidiIsolateRTL:
+		return rli + s + pdi
+	case BidiIsolateLTR:
+		return lri + s + pdi
+	case BidiMarks:
+		return rlm + s + lrm
+	}
+	return s
+}
+
+// isBidiControl reports whether r is a bidi mark, embedding, override or
+// isolate character. Parsers skip them so that wrapped dates can be read
+// back.
+func isBidiControl(r rune) bool {
+	return r == '\u200e' || r == '\u200f' || r == '\u061c' ||
+		(r >= '\u202a' && r <= '\u202e') || (r >= '\u2066' && r <= '\u2069')
+}
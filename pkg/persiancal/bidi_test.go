@@ -0,0 +1,110 @@
+package persiancal
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// bidiModeNamed returns the mode whose String is name
+func bidiModeNamed(t *testing.T, name string) BidiMode {
+	t.Helper()
+	for m := BidiNone; m <= BidiMarks; m++ {
+		if m.String() == name {
+			return m
+		}
+	}
+	t.Fatalf("unknown bidi mode %q", name)
+	return BidiNone
+}
+
+// digitSetNamed returns the digit set whose String is name
+func digitSetNamed(t *testing.T, name string) DigitSet {
+	t.Helper()
+	for d := DigitsNone; d <= DigitsMixed; d++ {
+		if d.String() == name {
+			return d
+		}
+	}
+	t.Fatalf("unknown digit set %q", name)
+	return DigitsNone
+}
+
+func TestBidiGolden(t *testing.T) {
+	const path = "testdata/bidi.golden"
+	lines, rows := readGolden(t, path, 4)
+	j := JalaliDate{1404, 8, 4}
+
+	for i, cols := range rows {
+		opts := FormatOptions{
+			Bidi:   bidiModeNamed(t, cols[0]),
+			Digits: digitSetNamed(t, cols[1]),
+		}
+		layout := cols[2]
+		got := j.FormatWith(layout, opts)
+		if *update {
+			lines[i] = strings.Join([]string{cols[0], cols[1], layout, strconv.Quote(got)}, "\t")
+			continue
+		}
+		want, err := strconv.Unquote(cols[3])
+		if err != nil {
+			t.Fatalf("%s:%d: %v", path, i+1, err)
+		}
+		if got != want {
+			t.Errorf("%s:%d: FormatWith(%q, %s, %s) = %q, want %q", path, i+1, layout, cols[0], cols[1], got, want)
+		}
+
+		// Parse skips the directional characters, so every layout it
+		// understands reads the wrapped date back
+		if strings.Contains(layout, "g") {
+			continue
+		}
+		if back, err := Parse(layout, got); err != nil || back != j {
+			t.Errorf("%s:%d: Parse(%q, %q) = %s, %v; want %s", path, i+1, layout, got, back, err, j)
+		}
+	}
+
+	if *update {
+		writeGolden(t, path, lines)
+	}
+}
+
+func TestBidiWrap(t *testing.T) {
+	tests := []struct {
+		mode BidiMode
+		s    string
+		want string
+	}{
+		{BidiNone, "۴ آبان", "۴ آبان"},
+		{BidiIsolate, "۴ آبان", "\u2068۴ آبان\u2069"},
+		{BidiIsolateRTL, "۴ آبان", "\u2067۴ آبان\u2069"},
+		{BidiIsolateLTR, "2025-10-26", "\u20662025-10-26\u2069"},
+		{BidiMarks, "۴ آبان", "\u200f۴ آبان\u200e"},
+		{BidiIsolate, "", ""},
+		{BidiMarks + 1, "۴ آبان", "۴ آبان"},
+	}
+	for _, tt := range tests {
+		if got := tt.mode.Wrap(tt.s); got != tt.want {
+			t.Errorf("%s.Wrap(%q) = %q, want %q", tt.mode, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestParseSkipsBidiControls(t *testing.T) {
+	j := JalaliDate{1404, 8, 4}
+	for _, value := range []string{
+		"\u2068۱۴۰۴/۰۸/۰۴\u2069",
+		"\u200f1404/08/04\u200e",
+		"\u202b1404/\u200e08/04\u202c",
+		"1404\u061c/08/04",
+	} {
+		if got, err := Parse(LayoutSlash, value); err != nil || got != j {
+			t.Errorf("Parse(%q) = %s, %v; want %s", value, got, err, j)
+		}
+	}
+
+	r, err := ParseAny("\u2068۴ آبان ۱۴۰۴\u2069", nil)
+	if err != nil || r.Date != j {
+		t.Errorf("ParseAny(isolated date) = %s, %v; want %s", r.Date, err, j)
+	}
+}
@@ -0,0 +1,250 @@
+package persiancal
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// yearStarts holds the day number of 1 Farvardin for every supported year,
+// plus the year after MaxYear, so that yearStarts[y-MinYear] is the start of
+// year y. It is built on first use by the bulk conversion functions.
+var (
+	yearStarts     []int32
+	yearStartsOnce sync.Once
+)
+
+// loadYearStarts returns the year-start table, building it if needed
+func loadYearStarts() []int32 {
+	yearStartsOnce.Do(func() {
+		yearStarts = make([]int32, MaxYear-MinYear+2)
+		for i := range yearStarts {
+			yearStarts[i] = int32(jalaliToJDN(MinYear+i, 1, 1))
+		}
+	})
+	return yearStarts
+}
+
+// tableJDNToJalali converts an in-range day number using the year-start
+// table instead of the leap-year pattern computation
+func tableJDNToJalali(starts []int32, jdn int) (jy, jm, jd int) {
+	// Estimate the year from the mean year length (365.2422 days), then
+	// correct the estimate, which is off by at most one year
+	i := (jdn - jalaliEpoch) * 10000 / 3652422
+	if i > len(starts)-2 {
+		i = len(starts) - 2
+	}
+	for i > 0 && int(starts[i]) > jdn {
+		i--
+	}
+	for int(starts[i+1]) <= jdn {
+		i++
+	}
+
+	jy = MinYear + i
+	yday := jdn - int(starts[i]) + 1
+	if yday <= 186 {
+		jm = 1 + (yday-1)/31
+		jd = ((yday - 1) % 31) + 1
+	} else {
+		jm = 7 + (yday-187)/30
+		jd = ((yday - 187) % 30) + 1
+	}
+	return
+}
+
+// tableJalaliToJDN converts a Jalali date to a day number using the
+// year-start table. The date is validated against the table.
+func tableJalaliToJDN(starts []int32, j JalaliDate) (int, error) {
+	if j.Year < MinYear || j.Year > MaxYear {
+		return 0, ErrOutOfRange
+	}
+	if j.Month < 1 || j.Month > 12 {
+		return 0, ErrInvalidMonth
+	}
+
+	i := j.Year - MinYear
+	maxDay := 31
+	switch {
+	case j.Month == 12:
+		maxDay = int(starts[i+1]-starts[i]) - 336
+	case j.Month > 6:
+		maxDay = 30
+	}
+	if j.Day < 1 || j.Day > maxDay {
+		return 0, ErrInvalidDay
+	}
+
+	var mdays int
+	if j.Month <= 7 {
+		mdays = (j.Month - 1) * 31
+	} else {
+		mdays = (j.Month-1)*30 + 6
+	}
+	return int(starts[i]) + mdays + j.Day - 1, nil
+}
+
+// FromGregorianSlice converts each time in src to a JalaliDate in dst.
+// Like copy, it converts min(len(dst), len(src)) elements and returns the
+// number converted. If an element is outside the supported range,
+// conversion stops there and ErrOutOfRange is returned.
+//
+// It is considerably faster than calling FromGregorianDate per element
+// because years are looked up in a precomputed table.
+func FromGregorianSlice(dst []JalaliDate, src []time.Time) (int, error) {
+	return fromGregorianSlice(dst, src, 0)
+}
+
+// fromGregorianSlice is FromGregorianSlice for the chunk of a larger slice
+// that starts at index base. Errors give element indexes in the larger slice.
+func fromGregorianSlice(dst []JalaliDate, src []time.Time, base int) (int, error) {
+	starts := loadYearStarts()
+	n := min(len(dst), len(src))
+	for i := 0; i < n; i++ {
+		jdn := DayNumberOf(src[i])
+		if jdn < MinDayNumber || jdn > MaxDayNumber {
+			return i, fmt.Errorf("%w: element %d (%s)", ErrOutOfRange, base+i, src[i].Format("2006-01-02"))
+		}
+		y, m, d := tableJDNToJalali(starts, int(jdn))
+		dst[i] = JalaliDate{Year: y, Month: m, Day: d}
+	}
+	return n, nil
+}
+
+// ToGregorianSlice converts each JalaliDate in src to a Gregorian time.Time
+// at midnight UTC in dst. Like copy, it converts min(len(dst), len(src))
+// elements and returns the number converted. If an element is invalid,
+// conversion stops there and the validation error is returned.
+func ToGregorianSlice(dst []time.Time, src []JalaliDate) (int, error) {
+	return toGregorianSlice(dst, src, 0)
+}
+
+// toGregorianSlice is ToGregorianSlice for the chunk of a larger slice that
+// starts at index base. Errors give element indexes in the larger slice.
+func toGregorianSlice(dst []time.Time, src []JalaliDate, base int) (int, error) {
+	starts := loadYearStarts()
+	n := min(len(dst), len(src))
+	for i := 0; i < n; i++ {
+		jdn, err := tableJalaliToJDN(starts, src[i])
+		if err != nil {
+			return i, fmt.Errorf("%w: element %d (%s)", err, base+i, src[i])
+		}
+		gy, gm, gd := jdnToGregorian(jdn)
+		dst[i] = time.Date(gy, time.Month(gm), gd, 0, 0, 0, 0, time.UTC)
+	}
+	return n, nil
+}
+
+// FromGregorianSliceParallel is like FromGregorianSlice but splits the work
+// across the given number of goroutines. A workers value of zero or less
+// uses GOMAXPROCS. On error it returns the index of the first element that
+// failed; elements after it may or may not have been converted.
+func FromGregorianSliceParallel(dst []JalaliDate, src []time.Time, workers int) (int, error) {
+	n := min(len(dst), len(src))
+	return parallelChunks(n, workers, func(lo, hi int) (int, error) {
+		k, err := fromGregorianSlice(dst[lo:hi], src[lo:hi], lo)
+		return lo + k, err
+	})
+}
+
+// ToGregorianSliceParallel is like ToGregorianSlice but splits the work
+// across the given number of goroutines. A workers value of zero or less
+// uses GOMAXPROCS.
+func ToGregorianSliceParallel(dst []time.Time, src []JalaliDate, workers int) (int, error) {
+	n := min(len(dst), len(src))
+	return parallelChunks(n, workers, func(lo, hi int) (int, error) {
+		k, err := toGregorianSlice(dst[lo:hi], src[lo:hi], lo)
+		return lo + k, err
+	})
+}
+
+// parallelChunks splits [0, n) into one chunk per worker and runs convert on
+// each concurrently. convert returns the absolute index it stopped at.
+// The earliest failure wins.
+func parallelChunks(n, workers int, convert func(lo, hi int) (int, error)) (int, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		return convert(0, n)
+	}
+
+	loadYearStarts()
+
+	stops := make([]int, workers)
+	errs := make([]error, workers)
+	size := (n + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		lo := w * size
+		hi := min(lo+size, n)
+		if lo >= hi {
+			stops[w] = hi
+			continue
+		}
+		wg.Add(1)
+		go func(w, lo, hi int) {
+			defer wg.Done()
+			stops[w], errs[w] = convert(lo, hi)
+		}(w, lo, hi)
+	}
+	wg.Wait()
+
+	for w, err := range errs {
+		if err != nil {
+			return stops[w], err
+		}
+	}
+	return n, nil
+}
+
+// JalaliResult is the outcome of converting one value in a stream
+type JalaliResult struct {
+	Date JalaliDate
+	Err  error
+}
+
+// FromGregorianStream converts times received from in and sends the results,
+// in order, on the returned channel. The output channel is closed when in is
+// closed or ctx is done.
+func FromGregorianStream(ctx context.Context, in <-chan time.Time) <-chan JalaliResult {
+	out := make(chan JalaliResult)
+	go func() {
+		defer close(out)
+		starts := loadYearStarts()
+		for {
+			var t time.Time
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case t, ok = <-in:
+				if !ok {
+					return
+				}
+			}
+
+			var r JalaliResult
+			jdn := DayNumberOf(t)
+			if jdn < MinDayNumber || jdn > MaxDayNumber {
+				r.Err = fmt.Errorf("%w: %s", ErrOutOfRange, t.Format("2006-01-02"))
+			} else {
+				y, m, d := tableJDNToJalali(starts, int(jdn))
+				r.Date = JalaliDate{Year: y, Month: m, Day: d}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case out <- r:
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,187 @@
+package persiancal
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// benchSize is the number of dates converted per benchmark iteration
+const benchSize = 10000
+
+// gregorianDays returns n days from 1 January 1900, 13 days apart so that
+// they cover several centuries
+func gregorianDays(n int) []time.Time {
+	ts := make([]time.Time, n)
+	start := time.Date(1900, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := range ts {
+		ts[i] = start.AddDate(0, 0, i*13)
+	}
+	return ts
+}
+
+// jalaliDays returns the Jalali dates of gregorianDays(n)
+func jalaliDays(n int) []JalaliDate {
+	ts := gregorianDays(n)
+	js := make([]JalaliDate, n)
+	for i, t := range ts {
+		js[i] = FromGregorianDate(t)
+	}
+	return js
+}
+
+func TestSlicesMatchPerItem(t *testing.T) {
+	// Every 3rd day of the supported range, so that each year start and
+	// every Esfand 30 is crossed
+	var ts []time.Time
+	for n := MinDayNumber; n <= MaxDayNumber; n += 3 {
+		ts = append(ts, n.Time(nil))
+	}
+	ts = append(ts, MaxDayNumber.Time(nil))
+
+	js := make([]JalaliDate, len(ts))
+	if n, err := FromGregorianSliceParallel(js, ts, 4); err != nil || n != len(ts) {
+		t.Fatalf("FromGregorianSliceParallel = %d, %v", n, err)
+	}
+	back := make([]time.Time, len(js))
+	if n, err := ToGregorianSlice(back, js); err != nil || n != len(js) {
+		t.Fatalf("ToGregorianSlice = %d, %v", n, err)
+	}
+	for i, g := range ts {
+		if want := FromGregorianDate(g); js[i] != want {
+			t.Fatalf("FromGregorianSlice[%d] (%s) = %s, want %s", i, g.Format(time.DateOnly), js[i], want)
+		}
+		if !back[i].Equal(g) {
+			t.Fatalf("ToGregorianSlice[%d] (%s) = %s, want %s", i, js[i], back[i], g)
+		}
+	}
+}
+
+func TestSliceErrors(t *testing.T) {
+	ts := []time.Time{
+		time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC),
+		time.Date(600, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	js := make([]JalaliDate, 2)
+	if n, err := FromGregorianSlice(js, ts); n != 1 || !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("FromGregorianSlice = %d, %v; want 1, ErrOutOfRange", n, err)
+	}
+
+	gs := make([]time.Time, 3)
+	src := []JalaliDate{{1403, 12, 30}, {1404, 1, 1}, {1404, 12, 30}}
+	if n, err := ToGregorianSlice(gs, src); n != 2 || !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("ToGregorianSlice = %d, %v; want 2, ErrInvalidDay", n, err)
+	}
+}
+
+func TestSliceParallelErrors(t *testing.T) {
+	// 100 elements over 4 workers: index 60 is the 10th of the third chunk
+	ts := gregorianDays(100)
+	ts[60] = time.Time{}
+	js := make([]JalaliDate, len(ts))
+	n, err := FromGregorianSliceParallel(js, ts, 4)
+	if n != 60 || !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("FromGregorianSliceParallel = %d, %v; want 60, ErrOutOfRange", n, err)
+	}
+	if want := "element 60 (0001-01-01)"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("FromGregorianSliceParallel error = %v, want it to name %q", err, want)
+	}
+
+	src := jalaliDays(100)
+	src[77] = JalaliDate{1404, 12, 30}
+	gs := make([]time.Time, len(src))
+	n, err = ToGregorianSliceParallel(gs, src, 4)
+	if n != 77 || !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("ToGregorianSliceParallel = %d, %v; want 77, ErrInvalidDay", n, err)
+	}
+	if want := "element 77 (1404/12/30)"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("ToGregorianSliceParallel error = %v, want it to name %q", err, want)
+	}
+}
+
+func TestFromGregorianStream(t *testing.T) {
+	in := make(chan time.Time)
+	go func() {
+		defer close(in)
+		in <- time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC)
+		in <- time.Date(600, 1, 1, 0, 0, 0, 0, time.UTC)
+	}()
+
+	var got []JalaliResult
+	for r := range FromGregorianStream(context.Background(), in) {
+		got = append(got, r)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d results, want 2", len(got))
+	}
+	if got[0].Err != nil || got[0].Date != (JalaliDate{1404, 8, 4}) {
+		t.Errorf("result 0 = %+v, want 1404/08/04", got[0])
+	}
+	if !errors.Is(got[1].Err, ErrOutOfRange) {
+		t.Errorf("result 1 error = %v, want ErrOutOfRange", got[1].Err)
+	}
+}
+
+func BenchmarkFromGregorianDate(b *testing.B) {
+	ts := gregorianDays(benchSize)
+	dst := make([]JalaliDate, benchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for k, t := range ts {
+			dst[k] = FromGregorianDate(t)
+		}
+	}
+}
+
+func BenchmarkFromGregorianSlice(b *testing.B) {
+	ts := gregorianDays(benchSize)
+	dst := make([]JalaliDate, benchSize)
+	loadYearStarts()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FromGregorianSlice(dst, ts)
+	}
+}
+
+func BenchmarkFromGregorianSliceParallel(b *testing.B) {
+	ts := gregorianDays(benchSize)
+	dst := make([]JalaliDate, benchSize)
+	loadYearStarts()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FromGregorianSliceParallel(dst, ts, 0)
+	}
+}
+
+func BenchmarkToGregorian(b *testing.B) {
+	js := jalaliDays(benchSize)
+	dst := make([]time.Time, benchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for k, j := range js {
+			dst[k] = j.ToGregorian()
+		}
+	}
+}
+
+func BenchmarkToGregorianSlice(b *testing.B) {
+	js := jalaliDays(benchSize)
+	dst := make([]time.Time, benchSize)
+	loadYearStarts()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ToGregorianSlice(dst, js)
+	}
+}
+
+func BenchmarkToGregorianSliceParallel(b *testing.B) {
+	js := jalaliDays(benchSize)
+	dst := make([]time.Time, benchSize)
+	loadYearStarts()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ToGregorianSliceParallel(dst, js, 0)
+	}
+}
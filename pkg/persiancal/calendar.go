@@ -0,0 +1,268 @@
+package persiancal
+
+// Calendar is a calendar system that can be converted through day numbers.
+// Any type implementing Calendar can be used with Convert, so calendars
+// outside this package plug in without changes to the conversion core.
+type Calendar interface {
+	// Name returns a short identifier such as "gregorian"
+	Name() string
+
+	// ToDayNumber returns the day number of a date, validating the month
+	// and day
+	ToDayNumber(year, month, day int) (DayNumber, error)
+
+	// FromDayNumber returns the date of a day number
+	FromDayNumber(n DayNumber) (year, month, day int)
+
+	// DaysInMonth returns the number of days in a month, or 0 if the month
+	// does not exist
+	DaysInMonth(year, month int) int
+
+	// MonthsInYear returns the number of months in a year
+	MonthsInYear(year int) int
+
+	// IsLeap reports whether a year has an extra day or month
+	IsLeap(year int) bool
+
+	// MonthName returns the Persian and English names of a month
+	MonthName(month int) MonthName
+}
+
+// Built-in calendars
+var (
+	// SolarHijriCalendar is the Jalali calendar used by JalaliDate. It only
+	// accepts years in MinYear..MaxYear.
+	SolarHijriCalendar Calendar = solarHijri{}
+
+	// GregorianCalendar is the proleptic Gregorian calendar
+	GregorianCalendar Calendar = gregorian{}
+
+	// JulianCalendar is the proleptic Julian calendar
+	JulianCalendar Calendar = julian{}
+
+	// IslamicCalendar is the tabular Islamic lunar calendar with the civil
+	// epoch (16 July 622 Julian) and leap years 2, 5, 7, 10, 13, 16, 18, 21,
+	// 24, 26 and 29 of each 30-year cycle. Dates may differ by a day or two
+	// from calendars based on sighting of the moon.
+	IslamicCalendar Calendar = islamic{}
+)
+
+// Convert converts a date from one calendar to another
+//
+//	y, m, d, err := persiancal.Convert(persiancal.SolarHijriCalendar,
+//		persiancal.IslamicCalendar, 1404, 8, 4)
+func Convert(from, to Calendar, year, month, day int) (y, m, d int, err error) {
+	n, err := from.ToDayNumber(year, month, day)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	y, m, d = to.FromDayNumber(n)
+	return y, m, d, nil
+}
+
+// validateDate checks that month and day exist in c
+func validateDate(c Calendar, year, month, day int) error {
+	if month < 1 || month > c.MonthsInYear(year) {
+		return ErrInvalidMonth
+	}
+	if day < 1 || day > c.DaysInMonth(year, month) {
+		return ErrInvalidDay
+	}
+	return nil
+}
+
+// Month names shared by the Gregorian and Julian calendars
+var westernMonthNames = []MonthName{
+	{Persian: "", English: ""}, // placeholder
+	{Persian: "ژانویه", English: "January"},
+	{Persian: "فوریه", English: "February"},
+	{Persian: "مارس", English: "March"},
+	{Persian: "آوریل", English: "April"},
+	{Persian: "مه", English: "May"},
+	{Persian: "ژوئن", English: "June"},
+	{Persian: "ژوئیه", English: "July"},
+	{Persian: "اوت", English: "August"},
+	{Persian: "سپتامبر", English: "September"},
+	{Persian: "اکتبر", English: "October"},
+	{Persian: "نوامبر", English: "November"},
+	{Persian: "دسامبر", English: "December"},
+}
+
+// Islamic month names
+var islamicMonthNames = []MonthName{
+	{Persian: "", English: ""}, // placeholder
+	{Persian: "محرم", English: "Muharram"},
+	{Persian: "صفر", English: "Safar"},
+	{Persian: "ربیع‌الاول", English: "Rabi al-Awwal"},
+	{Persian: "ربیع‌الثانی", English: "Rabi al-Thani"},
+	{Persian: "جمادی‌الاول", English: "Jumada al-Awwal"},
+	{Persian: "جمادی‌الثانی", English: "Jumada al-Thani"},
+	{Persian: "رجب", English: "Rajab"},
+	{Persian: "شعبان", English: "Shaban"},
+	{Persian: "رمضان", English: "Ramadan"},
+	{Persian: "شوال", English: "Shawwal"},
+	{Persian: "ذی‌القعده", English: "Dhu al-Qadah"},
+	{Persian: "ذی‌الحجه", English: "Dhu al-Hijjah"},
+}
+
+// monthNameIn returns names[month], or an empty MonthName if month is out
+// of range
+func monthNameIn(names []MonthName, month int) MonthName {
+	if month < 1 || month >= len(names) {
+		return MonthName{}
+	}
+	return names[month]
+}
+
+// solarHijri implements Calendar for the Jalali calendar
+type solarHijri struct{}
+
+func (solarHijri) Name() string { return "solar-hijri" }
+
+func (solarHijri) ToDayNumber(year, month, day int) (DayNumber, error) {
+	return JalaliToDayNumber(year, month, day)
+}
+
+func (solarHijri) FromDayNumber(n DayNumber) (year, month, day int) {
+	return jdnToJalali(int(n))
+}
+
+func (solarHijri) DaysInMonth(year, month int) int { return daysInJalaliMonth(year, month) }
+
+func (solarHijri) MonthsInYear(int) int { return 12 }
+
+func (solarHijri) IsLeap(year int) bool { return isJalaliLeap(year) }
+
+func (solarHijri) MonthName(month int) MonthName { return monthNameIn(persianMonthNames, month) }
+
+// gregorian implements Calendar for the proleptic Gregorian calendar
+type gregorian struct{}
+
+func (gregorian) Name() string { return "gregorian" }
+
+func (gregorian) ToDayNumber(year, month, day int) (DayNumber, error) {
+	return GregorianToDayNumber(year, month, day)
+}
+
+func (gregorian) FromDayNumber(n DayNumber) (year, month, day int) {
+	return jdnToGregorian(int(n))
+}
+
+func (gregorian) DaysInMonth(year, month int) int {
+	if month < 1 || month > 12 {
+		return 0
+	}
+	return daysInGregorianMonth(year, month)
+}
+
+func (gregorian) MonthsInYear(int) int { return 12 }
+
+func (gregorian) IsLeap(year int) bool { return isGregorianLeap(year) }
+
+func (gregorian) MonthName(month int) MonthName { return monthNameIn(westernMonthNames, month) }
+
+// julian implements Calendar for the proleptic Julian calendar
+type julian struct{}
+
+func (julian) Name() string { return "julian" }
+
+func (c julian) ToDayNumber(year, month, day int) (DayNumber, error) {
+	if err := validateDate(c, year, month, day); err != nil {
+		return 0, err
+	}
+	return DayNumber(julianToJDN(year, month, day)), nil
+}
+
+func (julian) FromDayNumber(n DayNumber) (year, month, day int) {
+	return jdnToJulian(int(n))
+}
+
+func (c julian) DaysInMonth(year, month int) int {
+	switch {
+	case month < 1 || month > 12:
+		return 0
+	case month == 2 && c.IsLeap(year):
+		return 29
+	default:
+		// Same as a common Gregorian year
+		return daysInGregorianMonth(1, month)
+	}
+}
+
+func (julian) MonthsInYear(int) int { return 12 }
+
+func (julian) IsLeap(year int) bool { return floorMod(year, 4) == 0 }
+
+func (julian) MonthName(month int) MonthName { return monthNameIn(westernMonthNames, month) }
+
+// julianToJDN converts a proleptic Julian date to Julian Day Number
+func julianToJDN(y, m, d int) int {
+	a := (14 - m) / 12
+	yy := y + 4800 - a
+	mm := m + 12*a - 3
+	return d + (153*mm+2)/5 + 365*yy + floorDiv(yy, 4) - 32083
+}
+
+// jdnToJulian converts a Julian Day Number to a proleptic Julian date
+func jdnToJulian(jdn int) (y, m, d int) {
+	c := jdn + 32082
+	yy := floorDiv(4*c+3, 1461)
+	e := c - floorDiv(1461*yy, 4)
+	mm := (5*e + 2) / 153
+
+	d = e - (153*mm+2)/5 + 1
+	m = mm + 3 - 12*(mm/10)
+	y = yy - 4800 + mm/10
+	return
+}
+
+// islamicEpoch is the Julian Day Number of 1 Muharram 1 (16 July 622 Julian)
+const islamicEpoch = 1948440
+
+// islamic implements Calendar for the tabular Islamic calendar
+type islamic struct{}
+
+func (islamic) Name() string { return "islamic" }
+
+func (c islamic) ToDayNumber(year, month, day int) (DayNumber, error) {
+	if err := validateDate(c, year, month, day); err != nil {
+		return 0, err
+	}
+	return DayNumber(islamicToJDN(year, month, day)), nil
+}
+
+func (islamic) FromDayNumber(n DayNumber) (year, month, day int) {
+	return jdnToIslamic(int(n))
+}
+
+func (c islamic) DaysInMonth(year, month int) int {
+	switch {
+	case month < 1 || month > 12:
+		return 0
+	case month == 12 && c.IsLeap(year):
+		return 30
+	case month%2 == 1:
+		return 30
+	default:
+		return 29
+	}
+}
+
+func (islamic) MonthsInYear(int) int { return 12 }
+
+func (islamic) IsLeap(year int) bool { return floorMod(14+11*year, 30) < 11 }
+
+func (islamic) MonthName(month int) MonthName { return monthNameIn(islamicMonthNames, month) }
+
+// islamicToJDN converts a tabular Islamic date to Julian Day Number
+func islamicToJDN(y, m, d int) int {
+	return d + (59*(m-1)+1)/2 + (y-1)*354 + floorDiv(3+11*y, 30) + islamicEpoch - 1
+}
+
+// jdnToIslamic converts a Julian Day Number to a tabular Islamic date
+func jdnToIslamic(jdn int) (y, m, d int) {
+	y = floorDiv(30*(jdn-islamicEpoch)+10646, 10631)
+	m = min(12, floorDiv(2*(jdn-29-islamicToJDN(y, 1, 1))+58, 59)+1)
+	d = jdn - islamicToJDN(y, m, 1) + 1
+	return
+}
@@ -0,0 +1,113 @@
+package persiancal
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestConvertKnownDates(t *testing.T) {
+	type date struct{ y, m, d int }
+	tests := []struct {
+		from, to Calendar
+		in, want date
+	}{
+		// The tabular civil calendar starts 1447 on 27 June 2025; Umm
+		// al-Qura and the sighted calendar started it a day earlier
+		{IslamicCalendar, GregorianCalendar, date{1447, 1, 1}, date{2025, 6, 27}},
+		{IslamicCalendar, GregorianCalendar, date{1446, 1, 1}, date{2024, 7, 8}},
+		{IslamicCalendar, GregorianCalendar, date{1446, 9, 1}, date{2025, 3, 1}},
+		{IslamicCalendar, GregorianCalendar, date{1445, 12, 30}, date{2024, 7, 7}},
+		{GregorianCalendar, IslamicCalendar, date{2000, 1, 1}, date{1420, 9, 24}},
+		{IslamicCalendar, JulianCalendar, date{1, 1, 1}, date{622, 7, 16}},
+		{IslamicCalendar, GregorianCalendar, date{1, 1, 1}, date{622, 7, 19}},
+
+		{JulianCalendar, GregorianCalendar, date{2025, 10, 13}, date{2025, 10, 26}},
+		{JulianCalendar, GregorianCalendar, date{2000, 1, 1}, date{2000, 1, 14}},
+		{JulianCalendar, GregorianCalendar, date{2100, 2, 29}, date{2100, 3, 14}},
+		// The last Julian day before the Gregorian reform
+		{JulianCalendar, GregorianCalendar, date{1582, 10, 4}, date{1582, 10, 14}},
+
+		{SolarHijriCalendar, IslamicCalendar, date{1404, 8, 4}, date{1447, 5, 4}},
+		{SolarHijriCalendar, JulianCalendar, date{1404, 8, 4}, date{2025, 10, 13}},
+		{SolarHijriCalendar, GregorianCalendar, date{1404, 1, 1}, date{2025, 3, 21}},
+	}
+	for _, tt := range tests {
+		y, m, d, err := Convert(tt.from, tt.to, tt.in.y, tt.in.m, tt.in.d)
+		if err != nil || (date{y, m, d}) != tt.want {
+			t.Errorf("Convert(%s → %s, %v) = %d/%d/%d, %v; want %v",
+				tt.from.Name(), tt.to.Name(), tt.in, y, m, d, err, tt.want)
+		}
+		// and back
+		y, m, d, err = Convert(tt.to, tt.from, tt.want.y, tt.want.m, tt.want.d)
+		if err != nil || (date{y, m, d}) != tt.in {
+			t.Errorf("Convert(%s → %s, %v) = %d/%d/%d, %v; want %v",
+				tt.to.Name(), tt.from.Name(), tt.want, y, m, d, err, tt.in)
+		}
+	}
+}
+
+func TestCalendarDayNumberRoundTrip(t *testing.T) {
+	// Every day from 1900 to 2100 reads back to the same day number, and
+	// consecutive days are consecutive dates
+	first := DayNumberOf(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC))
+	last := DayNumberOf(time.Date(2100, 12, 31, 0, 0, 0, 0, time.UTC))
+	for _, c := range []Calendar{SolarHijriCalendar, GregorianCalendar, JulianCalendar, IslamicCalendar} {
+		py, pm, pd := c.FromDayNumber(first - 1)
+		for n := first; n <= last; n++ {
+			y, m, d := c.FromDayNumber(n)
+			back, err := c.ToDayNumber(y, m, d)
+			if err != nil || back != n {
+				t.Fatalf("%s: day %d is %d/%d/%d, which reads back as %d, %v", c.Name(), n, y, m, d, back, err)
+			}
+			next := d == pd+1 && m == pm && y == py
+			if !next && d == 1 && pd == c.DaysInMonth(py, pm) {
+				next = (m == pm+1 && y == py) || (m == 1 && pm == c.MonthsInYear(py) && y == py+1)
+			}
+			if !next {
+				t.Fatalf("%s: %d/%d/%d follows %d/%d/%d", c.Name(), y, m, d, py, pm, pd)
+			}
+			py, pm, pd = y, m, d
+		}
+	}
+}
+
+func TestCalendarValidation(t *testing.T) {
+	tests := []struct {
+		c       Calendar
+		y, m, d int
+		want    error
+	}{
+		{GregorianCalendar, 2100, 2, 29, ErrInvalidDay},
+		{GregorianCalendar, 2000, 2, 29, nil},
+		{JulianCalendar, 2100, 2, 29, nil},
+		{JulianCalendar, 2101, 2, 29, ErrInvalidDay},
+		{IslamicCalendar, 1445, 12, 30, nil},
+		{IslamicCalendar, 1446, 12, 30, ErrInvalidDay},
+		{IslamicCalendar, 1446, 2, 30, ErrInvalidDay},
+		{IslamicCalendar, 1446, 13, 1, ErrInvalidMonth},
+		{SolarHijriCalendar, 1404, 12, 30, ErrInvalidDay},
+	}
+	for _, tt := range tests {
+		if _, err := tt.c.ToDayNumber(tt.y, tt.m, tt.d); !errors.Is(err, tt.want) {
+			t.Errorf("%s.ToDayNumber(%d, %d, %d) error = %v, want %v", tt.c.Name(), tt.y, tt.m, tt.d, err, tt.want)
+		}
+	}
+
+	// Leap years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of each cycle
+	var leaps []int
+	for y := 1411; y <= 1440; y++ {
+		if IslamicCalendar.IsLeap(y) {
+			leaps = append(leaps, (y-1)%30+1)
+		}
+	}
+	want := []int{2, 5, 7, 10, 13, 16, 18, 21, 24, 26, 29}
+	if len(leaps) != len(want) {
+		t.Fatalf("Islamic leap years in a cycle = %v, want %v", leaps, want)
+	}
+	for i := range want {
+		if leaps[i] != want[i] {
+			t.Fatalf("Islamic leap years in a cycle = %v, want %v", leaps, want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package persiancal
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock provides the current time. The package reads the time through a
+// Clock so that tests can freeze or advance it; see SetClock.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts an ordinary function to the Clock interface
+type ClockFunc func() time.Time
+
+// Now returns f()
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock is the Clock backed by time.Now
+var SystemClock Clock = ClockFunc(time.Now)
+
+// FixedClock returns a Clock that always reports t
+func FixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time { return t })
+}
+
+// FakeClock is a Clock whose time only changes when it is set or advanced.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+// NewFakeClock creates a FakeClock reporting t
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{t: t}
+}
+
+// Now returns the clock's current time
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+// Set changes the clock's current time to t
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = t
+}
+
+// Advance moves the clock's current time forward by d
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(d)
+}
+
+var (
+	clockMu sync.RWMutex
+	clock   = SystemClock
+)
+
+// SetClock replaces the clock used by Now, NowIn, Today and TodayIn and
+// returns the previous one. Passing nil restores SystemClock.
+//
+//	defer persiancal.SetClock(persiancal.SetClock(persiancal.FixedClock(t)))
+func SetClock(c Clock) Clock {
+	if c == nil {
+		c = SystemClock
+	}
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	prev := clock
+	clock = c
+	return prev
+}
+
+// CurrentClock returns the clock used by the package
+func CurrentClock() Clock {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return clock
+}
+
+var (
+	tehranOnce sync.Once
+	tehran     *time.Location
+)
+
+// TehranLocation returns the Asia/Tehran time zone, the recommended
+// location for deciding the current Jalali date. If the system has no time
+// zone database, a fixed UTC+03:30 zone is returned instead; Iran has not
+// observed daylight saving time since 2022.
+func TehranLocation() *time.Location {
+	tehranOnce.Do(func() {
+		loc, err := time.LoadLocation("Asia/Tehran")
+		if err != nil {
+			loc = time.FixedZone("+0330", 3*60*60+30*60)
+		}
+		tehran = loc
+	})
+	return tehran
+}
+
+// NowIn returns the current Jalali date in loc.
+// A nil location is treated as UTC.
+func NowIn(loc *time.Location) JalaliDate {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return FromGregorianDate(CurrentClock().Now().In(loc))
+}
+
+// TodayIn returns the current Jalali date in loc. It is the same as NowIn
+// and exists for call sites that read better with it.
+func TodayIn(loc *time.Location) JalaliDate {
+	return NowIn(loc)
+}
+
+// Today returns the current Jalali date in Tehran. Unlike Now, the result
+// does not depend on the time zone of the machine running the code.
+func Today() JalaliDate {
+	return NowIn(TehranLocation())
+}
@@ -0,0 +1,123 @@
+package persiancal
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestToday(t *testing.T) {
+	// Tehran is UTC+03:30, so its day starts at 20:30 UTC
+	tests := []struct {
+		now         time.Time
+		utc, tehran JalaliDate
+	}{
+		{time.Date(2025, 10, 26, 10, 0, 0, 0, time.UTC), JalaliDate{1404, 8, 4}, JalaliDate{1404, 8, 4}},
+		{time.Date(2025, 10, 26, 20, 29, 59, 0, time.UTC), JalaliDate{1404, 8, 4}, JalaliDate{1404, 8, 4}},
+		{time.Date(2025, 10, 26, 20, 30, 0, 0, time.UTC), JalaliDate{1404, 8, 4}, JalaliDate{1404, 8, 5}},
+		{time.Date(2025, 10, 26, 23, 59, 0, 0, time.UTC), JalaliDate{1404, 8, 4}, JalaliDate{1404, 8, 5}},
+		// Nowruz starts in Tehran before it does in UTC
+		{time.Date(2025, 3, 20, 21, 0, 0, 0, time.UTC), JalaliDate{1403, 12, 30}, JalaliDate{1404, 1, 1}},
+	}
+	for _, tt := range tests {
+		func() {
+			defer SetClock(SetClock(FixedClock(tt.now)))
+			if got := Today(); got != tt.tehran {
+				t.Errorf("at %v, Today() = %s, want %s", tt.now, got, tt.tehran)
+			}
+			if got := TodayIn(TehranLocation()); got != tt.tehran {
+				t.Errorf("at %v, TodayIn(Tehran) = %s, want %s", tt.now, got, tt.tehran)
+			}
+			if got := NowIn(nil); got != tt.utc {
+				t.Errorf("at %v, NowIn(nil) = %s, want %s", tt.now, got, tt.utc)
+			}
+			// Now uses the location of the clock's time, here UTC
+			if got := Now(); got != tt.utc {
+				t.Errorf("at %v, Now() = %s, want %s", tt.now, got, tt.utc)
+			}
+		}()
+	}
+}
+
+func TestFixedClock(t *testing.T) {
+	at := time.Date(2025, 10, 26, 10, 0, 0, 0, TehranLocation())
+	c := FixedClock(at)
+	for range 3 {
+		if got := c.Now(); !got.Equal(at) || got.Location() != at.Location() {
+			t.Fatalf("FixedClock(%v).Now() = %v", at, got)
+		}
+	}
+
+	// In Tehran's location, Now gives the Tehran date
+	defer SetClock(SetClock(FixedClock(time.Date(2025, 10, 26, 22, 0, 0, 0, TehranLocation()))))
+	if got := Now(); got != (JalaliDate{1404, 8, 4}) {
+		t.Errorf("Now() = %s, want 1404/08/04", got)
+	}
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2025, 10, 26, 20, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+	defer SetClock(SetClock(c))
+
+	if got := Today(); got != (JalaliDate{1404, 8, 4}) {
+		t.Errorf("Today() = %s, want 1404/08/04", got)
+	}
+	c.Advance(30 * time.Minute)
+	if got := c.Now(); !got.Equal(start.Add(30 * time.Minute)) {
+		t.Errorf("after Advance, Now() = %v, want %v", got, start.Add(30*time.Minute))
+	}
+	if got := Today(); got != (JalaliDate{1404, 8, 5}) {
+		t.Errorf("after Advance, Today() = %s, want 1404/08/05", got)
+	}
+	c.Advance(-time.Second)
+	if got := Today(); got != (JalaliDate{1404, 8, 4}) {
+		t.Errorf("after Advance(-1s), Today() = %s, want 1404/08/04", got)
+	}
+	c.Set(time.Date(2026, 3, 20, 21, 0, 0, 0, time.UTC))
+	if got := Today(); got != (JalaliDate{1405, 1, 1}) {
+		t.Errorf("after Set, Today() = %s, want 1405/01/01", got)
+	}
+}
+
+func TestFakeClockConcurrent(t *testing.T) {
+	start := time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				c.Advance(time.Second)
+				_ = c.Now()
+			}
+		}()
+	}
+	wg.Wait()
+	if got, want := c.Now(), start.Add(800*time.Second); !got.Equal(want) {
+		t.Errorf("Now() = %v, want %v", got, want)
+	}
+}
+
+func TestSetClock(t *testing.T) {
+	at := time.Date(2025, 10, 26, 10, 0, 0, 0, time.UTC)
+	defer SetClock(SetClock(FixedClock(at)))
+
+	// SetClock returns the previous clock
+	if got := SetClock(nil).Now(); !got.Equal(at) {
+		t.Errorf("SetClock(nil) returned a clock reporting %v, want %v", got, at)
+	}
+	// nil restores the system clock
+	before := time.Now()
+	now := CurrentClock().Now()
+	if now.Before(before) || now.Sub(before) > time.Minute {
+		t.Errorf("after SetClock(nil), Now() = %v, want about %v", now, before)
+	}
+
+	f := NewFakeClock(time.Time{})
+	SetClock(f)
+	if CurrentClock() != Clock(f) {
+		t.Errorf("CurrentClock() = %v, want the fake clock", CurrentClock())
+	}
+}
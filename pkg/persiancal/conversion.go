@@ -6,18 +6,115 @@ import "time"
 const (
 	gregorianEpoch = 1721426 // Julian day number of Gregorian epoch (0001-01-01)
 	jalaliEpoch    = 1948321 // Julian day number of Jalali epoch (0001-01-01)
+	unixEpoch      = 2440588 // Julian day number of the Unix epoch (1970-01-01)
 )
 
+// Supported range of Jalali years.
+//
+// The conversion core is exact integer arithmetic and is well defined far
+// beyond these limits, but dates before the Jalali epoch have no historical
+// meaning and years past 9999 do not fit the four-digit layouts. Functions
+// that can report an error return ErrOutOfRange for dates outside
+// MinDate..MaxDate.
+//
+// Leap years follow the 33-year pattern of the official calendar, whose
+// years start at the vernal equinox observed in Tehran. The pattern agrees
+// with the equinox for years up to 3177; later years continue the pattern
+// and may drift from the astronomical calendar by a day.
+const (
+	MinYear = 1
+	MaxYear = 9999
+)
+
+// Supported range of day numbers: 1 Farvardin 1 (22 March 622) through
+// 29 Esfand 9999 (19 March 10621)
+const (
+	MinDayNumber DayNumber = jalaliEpoch
+	MaxDayNumber DayNumber = 5600378
+)
+
+// MinDate and MaxDate are the earliest and latest supported Jalali dates
+var (
+	MinDate = JalaliDate{Year: MinYear, Month: 1, Day: 1}
+	MaxDate = JalaliDate{Year: MaxYear, Month: 12, Day: 29}
+)
+
+// DayNumber is a Julian Day Number: the count of days since 1 January 4713 BC
+// in the proleptic Julian calendar. It identifies a calendar day independent
+// of any calendar system, so differences between day numbers are exact day
+// counts.
+type DayNumber int
+
+// JalaliToDayNumber returns the day number of a Jalali date.
+// Returns ErrOutOfRange for years outside MinYear..MaxYear.
+func JalaliToDayNumber(year, month, day int) (DayNumber, error) {
+	j := JalaliDate{Year: year, Month: month, Day: day}
+	if err := j.Validate(); err != nil {
+		return 0, err
+	}
+	return DayNumber(jalaliToJDN(year, month, day)), nil
+}
+
+// GregorianToDayNumber returns the day number of a proleptic Gregorian date
+func GregorianToDayNumber(year, month, day int) (DayNumber, error) {
+	if month < 1 || month > 12 {
+		return 0, ErrInvalidMonth
+	}
+	if day < 1 || day > daysInGregorianMonth(year, month) {
+		return 0, ErrInvalidDay
+	}
+	return DayNumber(gregorianToJDN(year, month, day)), nil
+}
+
+// DayNumberOf returns the day number of the calendar day of t in t's location
+func DayNumberOf(t time.Time) DayNumber {
+	y, m, d := t.Date()
+	return DayNumber(gregorianToJDN(y, int(m), d))
+}
+
+// Jalali returns the Jalali date of the day number.
+// Returns ErrOutOfRange outside MinDayNumber..MaxDayNumber.
+func (n DayNumber) Jalali() (JalaliDate, error) {
+	if n < MinDayNumber || n > MaxDayNumber {
+		return JalaliDate{}, ErrOutOfRange
+	}
+	y, m, d := jdnToJalali(int(n))
+	return JalaliDate{Year: y, Month: m, Day: d}, nil
+}
+
+// Gregorian returns the proleptic Gregorian date of the day number
+func (n DayNumber) Gregorian() (year, month, day int) {
+	return jdnToGregorian(int(n))
+}
+
+// Time returns midnight at the start of the day in loc.
+// A nil location is treated as UTC.
+func (n DayNumber) Time(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	y, m, d := jdnToGregorian(int(n))
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, loc)
+}
+
+// Weekday returns the day of the week of the day number
+func (n DayNumber) Weekday() time.Weekday {
+	return time.Weekday(floorMod(int(n)+1, 7))
+}
+
+// RataDie returns the Rata Die count of the day, where 1 January 1 of the
+// proleptic Gregorian calendar is day 1
+func (n DayNumber) RataDie() int {
+	return int(n) - gregorianEpoch + 1
+}
+
 // FromGregorian converts a Gregorian time.Time to Jalali date components.
 // Returns year, month (1-12), and day (1-31).
+//
+// Dates outside the supported range are extrapolated with the same
+// arithmetic; use DayNumberOf and DayNumber.Jalali to detect them.
 func FromGregorian(t time.Time) (jy, jm, jd int) {
-	gy := t.Year()
-	gm := int(t.Month())
-	gd := t.Day()
-
-	gDays := gregorianToJDN(gy, gm, gd)
-	jy, jm, jd = jdnToJalali(gDays)
-	return
+	return jdnToJalali(int(DayNumberOf(t)))
 }
 
 // ToGregorian converts a Jalali date to Gregorian time.Time.
@@ -28,42 +125,95 @@ func ToGregorian(jy, jm, jd int) time.Time {
 	return time.Date(gy, time.Month(gm), gd, 0, 0, 0, 0, time.UTC)
 }
 
-// gregorianToJDN converts a Gregorian date to Julian Day Number
+// gregorianToJDN converts a Gregorian date to Julian Day Number.
+// Uses 400-year eras so that it is exact for negative years as well.
 func gregorianToJDN(gy, gm, gd int) int {
-	// Adjust for months before March
-	if gm < 3 {
+	// Count years from March so that the leap day ends the year
+	if gm <= 2 {
 		gy--
-		gm += 12
 	}
 
-	a := gy / 100
-	b := 2 - a + (a / 4)
+	era := floorDiv(gy, 400)
+	yoe := gy - era*400 // year of era [0, 399]
 
-	jdn := int(365.25*float64(gy+4716)) + int(30.6001*float64(gm+1)) + gd + b - 1524
-	return jdn
+	mp := (gm + 9) % 12                    // March = 0
+	doy := (153*mp+2)/5 + gd - 1           // day of year [0, 365]
+	doe := yoe*365 + yoe/4 - yoe/100 + doy // day of era [0, 146096]
+	days := era*146097 + doe - 719468      // days since 1970-01-01
+	return days + unixEpoch
 }
 
 // jdnToGregorian converts a Julian Day Number to Gregorian date
 func jdnToGregorian(jdn int) (gy, gm, gd int) {
-	a := jdn + 32044
-	b := (4*a + 3) / 146097
-	c := a - (146097*b)/4
+	z := jdn - unixEpoch + 719468
 
-	d := (4*c + 3) / 1461
-	e := c - (1461*d)/4
-	m := (5*e + 2) / 153
+	era := floorDiv(z, 146097)
+	doe := z - era*146097                                  // day of era [0, 146096]
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365 // year of era [0, 399]
+	doy := doe - (365*yoe + yoe/4 - yoe/100)               // day of year [0, 365]
+	mp := (5*doy + 2) / 153                                // March = 0
 
-	gd = e - (153*m+2)/5 + 1
-	gm = m + 3 - 12*(m/10)
-	gy = 100*b + d - 4800 + m/10
+	gd = doy - (153*mp+2)/5 + 1
+	gm = (mp+2)%12 + 1
+	gy = yoe + era*400
+	if gm <= 2 {
+		gy++
+	}
 
 	return
 }
 
+// jalaliBreaks are the years in which the 33-year leap pattern of the
+// Jalali calendar shifts, after Borkowski's table of astronomical vernal
+// equinoxes. Between breaks, leap years repeat every 33 years. Before the
+// first and after the last break the pattern is extended unchanged.
+var jalaliBreaks = []int{
+	-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
+	1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178,
+}
+
+// jalaliYear returns the day of March of the Gregorian year jy+621 on which
+// 1 Farvardin jy falls, and the number of years since the last leap year
+// (0 for a leap year)
+func jalaliYear(jy int) (march, leap int) {
+	// Leap years counted up to jy, and the length of the 33-year pattern
+	// segment jy is in. The segments before the first and after the last
+	// break never end.
+	leapJ := -14
+	jp := jalaliBreaks[0]
+	jump := -1
+	if jy >= jp {
+		for _, jm := range jalaliBreaks[1:] {
+			if jy < jm {
+				jump = jm - jp
+				break
+			}
+			leapJ += (jm-jp)/33*8 + (jm-jp)%33/4
+			jp = jm
+		}
+	}
+
+	n := jy - jp
+	leapJ += floorDiv(n, 33)*8 + floorDiv(floorMod(n, 33)+3, 4)
+	if jump >= 0 && jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+
+	// Gregorian leap years counted up to the same year
+	gy := jy + 621
+	leapG := floorDiv(gy, 4) - floorDiv((floorDiv(gy, 100)+1)*3, 4) - 150
+	march = 20 + leapJ - leapG
+
+	if jump >= 0 && jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = floorMod(floorMod(n+1, 33)-1, 4)
+	return march, leap
+}
+
 // jalaliToJDN converts a Jalali date to Julian Day Number
 func jalaliToJDN(jy, jm, jd int) int {
-	epbase := jy - 474
-	epyear := 474 + (epbase % 2820)
+	march, _ := jalaliYear(jy)
 
 	var mdays int
 	if jm <= 7 {
@@ -72,77 +222,40 @@ func jalaliToJDN(jy, jm, jd int) int {
 		mdays = (jm-1)*30 + 6
 	}
 
-	jdn := jd + mdays +
-		(epyear*682-110)/2816 +
-		(epyear-1)*365 +
-		(epbase/2820)*1029983 +
-		jalaliEpoch - 1
-
-	return jdn
+	return gregorianToJDN(jy+621, 3, march) + mdays + jd - 1
 }
 
 // jdnToJalali converts a Julian Day Number to Jalali date
 func jdnToJalali(jdn int) (jy, jm, jd int) {
-	// Calculate depoch (days since Jalali epoch)
-	depoch := jdn - jalaliToJDN(475, 1, 1)
-
-	// Calculate the 2820-year cycle
-	cycle := depoch / 1029983
-	cyear := depoch % 1029983
-
-	if cyear < 0 {
-		cyear += 1029983
-		cycle--
-	}
-
-	// Handle the last day of the cycle
-	if cyear == 1029982 {
-		ycycle := 2820
-		jy = ycycle + 2820*cycle + 474
-		if jy <= 0 {
-			jy--
-		}
-		jm = 12
-		jd = 30
-		return
-	}
-
-	// Calculate year within the cycle
-	aux1 := cyear / 366
-	aux2 := cyear % 366
+	gy, _, _ := jdnToGregorian(jdn)
+	jy = gy - 621
 
-	ycycle := (2816*aux2 + 2134*aux1 + 2815) / 1028522
-	ycycle += aux1 + 1
-
-	jy = ycycle + 2820*cycle + 474
-	if jy <= 0 {
+	// Day of the year counted from 0, moving to the previous year if jdn is
+	// before Nowruz
+	yday := jdn - jalaliToJDN(jy, 1, 1)
+	if yday < 0 {
 		jy--
+		yday = jdn - jalaliToJDN(jy, 1, 1)
 	}
 
-	// Calculate day of year
-	yday := jdn - jalaliToJDN(jy, 1, 1) + 1
-
 	// Determine month and day from day of year
-	if yday <= 186 {
+	if yday < 186 {
 		// First 6 months (31 days each)
-		jm = 1 + (yday-1)/31
-		jd = ((yday - 1) % 31) + 1
+		jm = 1 + yday/31
+		jd = yday%31 + 1
 	} else {
 		// Last 6 months (30 days each, except last month)
-		jm = 7 + (yday-187)/30
-		jd = ((yday - 187) % 30) + 1
+		jm = 7 + (yday-186)/30
+		jd = (yday-186)%30 + 1
 	}
 
 	return
 }
 
-// isJalaliLeap checks if a Jalali year is a leap year using the 2820-year cycle
+// isJalaliLeap checks if a Jalali year is a leap year
 func isJalaliLeap(jy int) bool {
-	// Algorithm based on 2820-year cycle
-	epbase := jy - 474
-	epyear := 474 + (epbase % 2820)
-
-	return ((epyear*682 - 110) % 2816) < 682
+	_, leap := jalaliYear(jy)
+	return leap == 0
 }
 
 // daysInJalaliMonth returns the number of days in a given Jalali month
@@ -162,3 +275,41 @@ func daysInJalaliMonth(jy, jm int) int {
 	}
 	return 29
 }
+
+// isGregorianLeap checks if a proleptic Gregorian year is a leap year
+func isGregorianLeap(gy int) bool {
+	return gy%4 == 0 && (gy%100 != 0 || gy%400 == 0)
+}
+
+// daysInGregorianMonth returns the number of days in a given Gregorian month
+func daysInGregorianMonth(gy, gm int) int {
+	switch gm {
+	case 2:
+		if isGregorianLeap(gy) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	default:
+		return 31
+	}
+}
+
+// floorDiv returns a/b rounded towards negative infinity
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// floorMod returns a modulo b with the sign of b
+func floorMod(a, b int) int {
+	m := a % b
+	if m != 0 && ((m < 0) != (b < 0)) {
+		m += b
+	}
+	return m
+}
@@ -0,0 +1,110 @@
+package persiancal
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNowruz(t *testing.T) {
+	// 1 Farvardin of each year, as published in the official calendar
+	tests := []struct {
+		year int
+		want string
+	}{
+		{1354, "1975-03-21"},
+		{1375, "1996-03-20"},
+		{1395, "2016-03-20"},
+		{1396, "2017-03-21"},
+		{1397, "2018-03-21"},
+		{1398, "2019-03-21"},
+		{1399, "2020-03-20"},
+		{1400, "2021-03-21"},
+		{1401, "2022-03-21"},
+		{1402, "2023-03-21"},
+		{1403, "2024-03-20"},
+		{1404, "2025-03-21"},
+		{1405, "2026-03-21"},
+		{1406, "2027-03-21"},
+		{1407, "2028-03-20"},
+	}
+	for _, tt := range tests {
+		got := ToGregorian(tt.year, 1, 1).Format(time.DateOnly)
+		if got != tt.want {
+			t.Errorf("ToGregorian(%d, 1, 1) = %s, want %s", tt.year, got, tt.want)
+		}
+		g, _ := time.Parse(time.DateOnly, tt.want)
+		if j := FromGregorianDate(g); j != (JalaliDate{Year: tt.year, Month: 1, Day: 1}) {
+			t.Errorf("FromGregorianDate(%s) = %s, want %d/01/01", tt.want, j, tt.year)
+		}
+	}
+}
+
+func TestIsLeapYear(t *testing.T) {
+	leap := map[int]bool{
+		1370: true, 1375: true, 1379: true, 1383: true, 1387: true, 1391: true,
+		1395: true, 1399: true, 1403: true, 1408: true, 1412: true,
+	}
+	for year := 1370; year <= 1412; year++ {
+		if got := IsLeapYear(year); got != leap[year] {
+			t.Errorf("IsLeapYear(%d) = %v, want %v", year, got, leap[year])
+		}
+	}
+}
+
+func TestEsfand30(t *testing.T) {
+	if _, err := New(1403, 12, 30); err != nil {
+		t.Errorf("New(1403, 12, 30) = %v, want a valid date", err)
+	}
+	if _, err := New(1404, 12, 30); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("New(1404, 12, 30) error = %v, want ErrInvalidDay", err)
+	}
+	if got := ToGregorian(1403, 12, 30).Format(time.DateOnly); got != "2025-03-20" {
+		t.Errorf("ToGregorian(1403, 12, 30) = %s, want 2025-03-20", got)
+	}
+}
+
+func TestFromGregorianDate(t *testing.T) {
+	tests := []struct {
+		gregorian string
+		want      JalaliDate
+	}{
+		{"2025-10-26", JalaliDate{1404, 8, 4}},
+		{"2024-03-19", JalaliDate{1402, 12, 29}},
+		{"1979-02-11", JalaliDate{1357, 11, 22}},
+		{"2000-01-01", JalaliDate{1378, 10, 11}},
+	}
+	for _, tt := range tests {
+		g, _ := time.Parse(time.DateOnly, tt.gregorian)
+		if got := FromGregorianDate(g); got != tt.want {
+			t.Errorf("FromGregorianDate(%s) = %s, want %s", tt.gregorian, got, tt.want)
+		}
+	}
+}
+
+func TestSupportedRange(t *testing.T) {
+	if got := MinDayNumber.Time(nil).Format(time.DateOnly); got != "0622-03-22" {
+		t.Errorf("MinDayNumber = %s, want 0622-03-22", got)
+	}
+	if n := DayNumber(jalaliToJDN(MaxDate.Year, MaxDate.Month, MaxDate.Day)); n != MaxDayNumber {
+		t.Errorf("MaxDate day number = %d, want %d", n, MaxDayNumber)
+	}
+	if _, err := (MaxDayNumber + 1).Jalali(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("(MaxDayNumber+1).Jalali() error = %v, want ErrOutOfRange", err)
+	}
+	if _, err := New(0, 12, 29); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("New(0, 12, 29) error = %v, want ErrOutOfRange", err)
+	}
+}
+
+func TestJDNRoundTrip(t *testing.T) {
+	for n := int(MinDayNumber); n <= int(MaxDayNumber); n += 7 {
+		y, m, d := jdnToJalali(n)
+		if d < 1 || d > daysInJalaliMonth(y, m) {
+			t.Fatalf("jdnToJalali(%d) = %d/%d/%d, not a valid date", n, y, m, d)
+		}
+		if got := jalaliToJDN(y, m, d); got != n {
+			t.Fatalf("jalaliToJDN(jdnToJalali(%d)) = %d", n, got)
+		}
+	}
+}
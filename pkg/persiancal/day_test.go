@@ -0,0 +1,165 @@
+package persiancal
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+func TestDay(t *testing.T) {
+	tests := []struct {
+		j JalaliDate
+		n Day
+	}{
+		{JalaliDate{1, 1, 1}, 0},
+		{JalaliDate{1, 1, 2}, 1},
+		{JalaliDate{2, 1, 1}, 365},
+		{JalaliDate{1404, 8, 4}, 512654},
+		{JalaliDate{1403, 12, 30}, 512434},
+		{MaxDate, Day(MaxDayNumber - MinDayNumber)},
+	}
+	for _, tt := range tests {
+		d, err := DayFromDate(tt.j)
+		if err != nil || d != tt.n {
+			t.Errorf("DayFromDate(%s) = %d, %v; want %d", tt.j, d, err, tt.n)
+		}
+		if got := tt.n.JalaliDate(); got != tt.j {
+			t.Errorf("Day(%d).JalaliDate() = %s, want %s", tt.n, got, tt.j)
+		}
+		if y, m, dd := tt.n.Date(); y != tt.j.Year || m != tt.j.Month || dd != tt.j.Day {
+			t.Errorf("Day(%d).Date() = %d, %d, %d; want %s", tt.n, y, m, dd, tt.j)
+		}
+		if tt.n.Year() != tt.j.Year || tt.n.Month() != tt.j.Month {
+			t.Errorf("Day(%d) Year, Month = %d, %d; want %s", tt.n, tt.n.Year(), tt.n.Month(), tt.j)
+		}
+		if got, want := tt.n.ToGregorian(), tt.j.ToGregorian(); !got.Equal(want) {
+			t.Errorf("Day(%d).ToGregorian() = %v, want %v", tt.n, got, want)
+		}
+		if got := tt.n.Weekday(); got != tt.j.DayOfWeek() {
+			t.Errorf("Day(%d).Weekday() = %s, want %s", tt.n, got, tt.j.DayOfWeek())
+		}
+	}
+
+	if size := unsafe.Sizeof(Day(0)); size != 4 {
+		t.Errorf("Day is %d bytes, want 4", size)
+	}
+}
+
+func TestNewDayErrors(t *testing.T) {
+	tests := []struct {
+		y, m, d int
+		want    error
+	}{
+		{1404, 12, 30, ErrInvalidDay},
+		{1404, 13, 1, ErrInvalidMonth},
+		{0, 1, 1, ErrOutOfRange},
+		{MaxYear + 1, 1, 1, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		if _, err := NewDay(tt.y, tt.m, tt.d); !errors.Is(err, tt.want) {
+			t.Errorf("NewDay(%d, %d, %d) error = %v, want %v", tt.y, tt.m, tt.d, err, tt.want)
+		}
+	}
+}
+
+func TestDayFromTime(t *testing.T) {
+	tehran := TehranLocation()
+	tests := []struct {
+		t    time.Time
+		want JalaliDate
+	}{
+		{time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC), JalaliDate{1404, 8, 4}},
+		// 22:00 UTC is already the next day in Tehran
+		{time.Date(2025, 10, 26, 22, 0, 0, 0, time.UTC), JalaliDate{1404, 8, 4}},
+		{time.Date(2025, 10, 26, 22, 0, 0, 0, time.UTC).In(tehran), JalaliDate{1404, 8, 5}},
+		{time.Date(622, 3, 22, 0, 0, 0, 0, time.UTC), JalaliDate{1, 1, 1}},
+	}
+	for _, tt := range tests {
+		d, err := DayFromTime(tt.t)
+		if err != nil || d.JalaliDate() != tt.want {
+			t.Errorf("DayFromTime(%v) = %s, %v; want %s", tt.t, d, err, tt.want)
+		}
+	}
+
+	if d, err := DayFromTime(time.Date(622, 3, 21, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("DayFromTime(622-03-21) = %s, %v; want ErrOutOfRange", d, err)
+	}
+
+	d, _ := NewDay(1404, 8, 4)
+	if got := d.Time(tehran); !got.Equal(time.Date(2025, 10, 26, 0, 0, 0, 0, tehran)) {
+		t.Errorf("%s.Time(Tehran) = %v, want midnight in Tehran", d, got)
+	}
+	if got := d.Time(nil); got.Location() != time.UTC {
+		t.Errorf("%s.Time(nil) is in %v, want UTC", d, got.Location())
+	}
+}
+
+func TestDayArithmetic(t *testing.T) {
+	d, _ := NewDay(1404, 12, 29)
+	tests := []struct {
+		n    int
+		want JalaliDate
+	}{
+		{0, JalaliDate{1404, 12, 29}},
+		{1, JalaliDate{1405, 1, 1}},
+		{-29, JalaliDate{1404, 11, 30}},
+		// 1405 is a common year
+		{365, JalaliDate{1405, 12, 29}},
+		{366, JalaliDate{1406, 1, 1}},
+	}
+	for _, tt := range tests {
+		got := d.Add(tt.n)
+		if got.JalaliDate() != tt.want {
+			t.Errorf("%s.Add(%d) = %s, want %s", d, tt.n, got, tt.want)
+		}
+		if diff := got.Sub(d); diff != tt.n {
+			t.Errorf("%s.Sub(%s) = %d, want %d", got, d, diff, tt.n)
+		}
+		if c := got.Compare(d); c != cmp.Compare(tt.n, 0) {
+			t.Errorf("%s.Compare(%s) = %d, want %d", got, d, c, cmp.Compare(tt.n, 0))
+		}
+		if got.Before(d) != (tt.n < 0) || got.After(d) != (tt.n > 0) {
+			t.Errorf("%s Before/After %s = %v/%v", got, d, got.Before(d), got.After(d))
+		}
+	}
+
+	days := []Day{d.Add(3), d, d.Add(-40), d.Add(1)}
+	slices.SortFunc(days, Day.Compare)
+	want := []string{"1404/11/19", "1404/12/29", "1405/01/01", "1405/01/03"}
+	for i := range days {
+		if days[i].String() != want[i] {
+			t.Fatalf("sorted days = %v, want %v", days, want)
+		}
+	}
+}
+
+func TestDayFormatVerbs(t *testing.T) {
+	// The verbs of Printable also apply to a Day inside slices, structs
+	// and pointers
+	d, _ := NewDay(1404, 8, 4)
+	tests := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%s", d, "1404-08-04"},
+		{"%q", d, `"1404-08-04"`},
+		{"%+v", d, "یکشنبه 4 آبان 1404"},
+		{"%12v|", d, "  1404/08/04|"},
+		{"%-12v|", d, "1404/08/04  |"},
+		{"%v", &d, "1404/08/04"},
+		{"%v", []Day{d, d.Add(1)}, "[1404/08/04 1404/08/05]"},
+		{"%+v", struct{ D Day }{d}, "{D:یکشنبه 4 آبان 1404}"},
+		{"%d", []Day{0, 1}, "[0 1]"},
+		{"%t", d, "%!t(persiancal.Day(512654))"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.arg); got != tt.want {
+			t.Errorf("Sprintf(%q, %#v) = %q, want %q", tt.format, tt.arg, got, tt.want)
+		}
+	}
+}
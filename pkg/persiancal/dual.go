@@ -0,0 +1,67 @@
+package persiancal
+
+// dualTokens are the layout tokens understood by JalaliDate.FormatWith: the
+// tokens of Format, and the same tokens with a g or h prefix for the
+// Gregorian and Hijri dates of the same day
+var dualTokens = append(append(append([]string{}, prefixTokens("g")...), prefixTokens("h")...), dateTokens...)
+
+// prefixTokens returns the year, month and day tokens with a prefix
+func prefixTokens(prefix string) []string {
+	var tokens []string
+	for _, token := range dateTokens {
+		if token[0] != 'E' {
+			tokens = append(tokens, prefix+token)
+		}
+	}
+	return tokens
+}
+
+// FormatOptions configures JalaliDate.FormatWith
+type FormatOptions struct {
+	// Digits, GregorianDigits and HijriDigits are the digits used for the
+	// Jalali, Gregorian (g) and Hijri (h) tokens. DigitsNone keeps Latin
+	// digits.
+	Digits          DigitSet
+	GregorianDigits DigitSet
+	HijriDigits     DigitSet
+
+	// Bidi wraps the output in Unicode directional characters
+	Bidi BidiMode
+}
+
+// FormatWith formats the date like Format, and can also print the same day
+// in other calendars: the year, month and day tokens with a g prefix
+// (gyyyy, gMMMM, gd, ...) give the Gregorian date, with Persian (gMMMM) or
+// English (gMMM) month names, and with an h prefix the tabular Hijri date.
+// The digits of each calendar are converted separately. Literal text is
+// copied unchanged, and the result is wrapped as selected by opts.Bidi.
+//
+//	j.FormatWith("d MMMM yyyy (gd gMMM gyyyy)", persiancal.FormatOptions{
+//		Digits: persiancal.DigitsPersian,
+//	}) // ۴ آبان ۱۴۰۴ (26 October 2025)
+func (j JalaliDate) FormatWith(layout string, opts FormatOptions) string {
+	jdn := jalaliToJDN(j.Year, j.Month, j.Day)
+	s := formatLayout(layout, dualTokens, func(token string) string {
+		switch token[0] {
+		case 'g':
+			y, m, d := jdnToGregorian(jdn)
+			return opts.GregorianDigits.convert(calendarField(GregorianCalendar, token[1:], y, m, d))
+		case 'h':
+			y, m, d := jdnToIslamic(jdn)
+			return opts.HijriDigits.convert(calendarField(IslamicCalendar, token[1:], y, m, d))
+		}
+		return opts.Digits.convert(formatField(token, j.Year, j.Month, j.Day))
+	})
+	return opts.Bidi.Wrap(s)
+}
+
+// calendarField renders a year, month or day token of a date in cal
+func calendarField(cal Calendar, token string, year, month, day int) string {
+	switch token {
+	case "MMMM":
+		return cal.MonthName(month).Persian
+	case "MMM":
+		return cal.MonthName(month).English
+	}
+	return formatField(token, year, month, day)
+}
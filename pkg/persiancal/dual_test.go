@@ -0,0 +1,44 @@
+package persiancal
+
+import "testing"
+
+func TestFormatWith(t *testing.T) {
+	j := JalaliDate{1404, 8, 4} // 26 October 2025
+	tests := []struct {
+		layout string
+		opts   FormatOptions
+		want   string
+	}{
+		{"d MMMM yyyy (gd gMMM gyyyy)", FormatOptions{Digits: DigitsPersian}, "۴ آبان ۱۴۰۴ (26 October 2025)"},
+		{"d MMMM yyyy (gd gMMM gyyyy)", FormatOptions{Digits: DigitsPersian, GregorianDigits: DigitsLatin}, "۴ آبان ۱۴۰۴ (26 October 2025)"},
+		{"gyyyy-gMM-gdd", FormatOptions{GregorianDigits: DigitsPersian}, "۲۰۲۵-۱۰-۲۶"},
+		{"gd gMMMM gyy", FormatOptions{}, "26 اکتبر 25"},
+		{"hd hMMMM hyyyy", FormatOptions{}, "4 جمادی‌الاول 1447"},
+		{"yyyy/MM/dd", FormatOptions{Digits: DigitsArabic}, "١٤٠٤/٠٨/٠٤"},
+		{"EEEE d MMMM", FormatOptions{}, "یکشنبه 4 آبان"},
+	}
+	for _, tt := range tests {
+		if got := j.FormatWith(tt.layout, tt.opts); got != tt.want {
+			t.Errorf("FormatWith(%q, %+v) = %q, want %q", tt.layout, tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestFormatIgnoresDualTokens(t *testing.T) {
+	j := JalaliDate{1404, 8, 4}
+	tests := []struct {
+		layout, want string
+	}{
+		{"ghd", "gh4"},
+		{"gd gMMM gyyyy", "g4 gAban g1404"},
+		{"yyyy/MM/dd", "1404/08/04"},
+	}
+	for _, tt := range tests {
+		if got := j.Format(tt.layout); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+	}
+	if got := j.FormatPersian("hd d"); got != "h۴ ۴" {
+		t.Errorf("FormatPersian(\"hd d\") = %q, want %q", got, "h۴ ۴")
+	}
+}
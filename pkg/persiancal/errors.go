@@ -1,6 +1,9 @@
 package persiancal
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Common errors
 var (
@@ -21,4 +24,45 @@ var (
 
 	// ErrInvalidDay is returned when day is out of range for the given month
 	ErrInvalidDay = errors.New("invalid day for the given month")
+
+	// ErrOutOfRange is returned when a date is outside MinDate..MaxDate
+	ErrOutOfRange = errors.New("date out of supported range")
+
+	// ErrInvalidQuarter is returned when quarter is out of range (1-4)
+	ErrInvalidQuarter = errors.New("invalid quarter: must be between 1 and 4")
+
+	// ErrAmbiguousDate is returned when a date could be read in more than
+	// one way
+	ErrAmbiguousDate = errors.New("ambiguous date")
 )
+
+// ParseError describes a value that does not match its layout. It wraps
+// ErrParseFailure, so errors.Is(err, ErrParseFailure) still holds.
+type ParseError struct {
+	Layout   string   // layout being matched
+	Value    string   // value being parsed, as given
+	Offset   int      // byte offset in Value of the first bad character
+	Token    string   // layout token being parsed, empty for literal text
+	Expected string   // what the layout called for, e.g. "2 digits" or "'/'"
+	Got      string   // what was found, e.g. "'-'" or "end of input"
+	Digits   DigitSet // digits used by Value
+	Err      error    // underlying error
+}
+
+// Error implements the error interface
+func (e *ParseError) Error() string {
+	msg := e.Err.Error() + ": expected " + e.Expected
+	if e.Token != "" {
+		msg += " for token " + e.Token
+	}
+	msg += fmt.Sprintf(" but got %s at offset %d", e.Got, e.Offset)
+	if e.Digits == DigitsArabic {
+		msg += " (value has Arabic-Indic digits; use Persian or Latin digits)"
+	}
+	return msg
+}
+
+// Unwrap returns the underlying error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
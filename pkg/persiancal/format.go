@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 // Format formats the JalaliDate according to the given layout.
@@ -16,24 +18,15 @@ import (
 //   - MMM: English month name (e.g., Aban)
 //   - dd: 2-digit day (e.g., 04)
 //   - d: day without leading zero (e.g., 4)
+//   - EEEE: Persian weekday name (e.g., شنبه)
+//   - EEE: English weekday name (e.g., Saturday)
+//
+// Other text is copied unchanged. The Gregorian and Hijri tokens of
+// FormatWith are not read, so a g or h in the layout stays a letter.
 func (j JalaliDate) Format(layout string) string {
-	result := layout
-
-	// Replace year
-	result = strings.ReplaceAll(result, "yyyy", fmt.Sprintf("%04d", j.Year))
-	result = strings.ReplaceAll(result, "yy", fmt.Sprintf("%02d", j.Year%100))
-
-	// Replace month (order matters: MMMM before MMM before MM before M)
-	result = strings.ReplaceAll(result, "MMMM", GetMonthNamePersian(j.Month))
-	result = strings.ReplaceAll(result, "MMM", GetMonthNameEnglish(j.Month))
-	result = strings.ReplaceAll(result, "MM", fmt.Sprintf("%02d", j.Month))
-	result = strings.ReplaceAll(result, "M", fmt.Sprintf("%d", j.Month))
-
-	// Replace day
-	result = strings.ReplaceAll(result, "dd", fmt.Sprintf("%02d", j.Day))
-	result = strings.ReplaceAll(result, "d", fmt.Sprintf("%d", j.Day))
-
-	return result
+	return formatLayout(layout, dateTokens, func(token string) string {
+		return formatField(token, j.Year, j.Month, j.Day)
+	})
 }
 
 // FormatPersian formats the date with Persian digits
@@ -42,162 +35,428 @@ func (j JalaliDate) FormatPersian(layout string) string {
 	return ToPersianDigits(formatted)
 }
 
+// dateTokens are the layout tokens understood by JalaliDate.Format and Parse.
+// Longer tokens come first so that they win over their prefixes.
+var dateTokens = []string{"yyyy", "yy", "MMMM", "MMM", "MM", "M", "dd", "d", "EEEE", "EEE"}
+
+// formatLayout renders layout by scanning it left to right and replacing
+// each token from tokens with render(token). Everything else, including the
+// output of render, is copied through unchanged.
+func formatLayout(layout string, tokens []string, render func(token string) string) string {
+	var b strings.Builder
+	for i := 0; i < len(layout); {
+		token := matchToken(layout[i:], tokens)
+		if token == "" {
+			b.WriteByte(layout[i])
+			i++
+			continue
+		}
+		b.WriteString(render(token))
+		i += len(token)
+	}
+	return b.String()
+}
+
+// matchToken returns the first token in tokens that s starts with, or ""
+func matchToken(s string, tokens []string) string {
+	for _, token := range tokens {
+		if strings.HasPrefix(s, token) {
+			return token
+		}
+	}
+	return ""
+}
+
+// formatField renders a year, month or day token
+func formatField(token string, year, month, day int) string {
+	switch token {
+	case "yyyy":
+		return fmt.Sprintf("%04d", year)
+	case "yy":
+		return fmt.Sprintf("%02d", year%100)
+	case "MMMM":
+		return GetMonthNamePersian(month)
+	case "MMM":
+		return GetMonthNameEnglish(month)
+	case "MM":
+		return fmt.Sprintf("%02d", month)
+	case "M":
+		return strconv.Itoa(month)
+	case "dd":
+		return fmt.Sprintf("%02d", day)
+	case "d":
+		return strconv.Itoa(day)
+	case "EEEE":
+		return GetWeekdayNamePersian(DayNumber(jalaliToJDN(year, month, day)).Weekday())
+	case "EEE":
+		return GetWeekdayNameEnglish(DayNumber(jalaliToJDN(year, month, day)).Weekday())
+	case "QQQ":
+		return "Q" + strconv.Itoa((month-1)/3+1)
+	case "Q":
+		return strconv.Itoa((month-1)/3 + 1)
+	}
+	return token
+}
+
 // Parse parses a date string according to the given layout.
-// Supported tokens: yyyy, yy, MM, M, MMMM, MMM, dd, d
-// Supports both Persian and Latin digits.
+// Supported tokens: yyyy, yy, MM, M, MMMM, MMM, dd, d, EEEE, EEE
+// Supports both Persian and Latin digits. A weekday name must match the
+// date.
+//
+// Two-digit years are resolved with a sliding window: they are placed
+// between 80 years before and 19 years after the current Jalali year, so in
+// 1404 "05" is 1405 and "50" is 1350. The current year is read from the
+// package clock (see SetClock) in Tehran, so the same yy value can parse
+// to a different year once the window moves. Use ParseWith for a fixed
+// pivot year, which does not depend on the clock, or for lenient parsing.
+//
+// If the value does not match the layout, the error is a *ParseError
+// describing the position of the first bad character.
 func Parse(layout, value string) (JalaliDate, error) {
-	value = ToLatinDigits(value)
+	return ParseWith(layout, value, ParseOptions{})
+}
 
-	var year, month, day int
-	var err error
+// ParseOptions configures ParseWith. The zero value parses strictly and
+// resolves two-digit years with a sliding window around today.
+type ParseOptions struct {
+	// PivotYear is the first year of the 100-year window two-digit years
+	// are placed in. With a pivot of 1350, "50" to "99" are 1350-1399 and
+	// "00" to "49" are 1400-1449. If zero, the window starts 80 years
+	// before the current Jalali year.
+	PivotYear int
 
-	tokens := []struct {
-		token  string
-		length int
-		setter func(string) error
-	}{
-		{"yyyy", 4, func(s string) error {
-			year, err = strconv.Atoi(s)
-			return err
-		}},
-		{"yy", 2, func(s string) error {
-			y, err := strconv.Atoi(s)
-			if err != nil {
-				return err
-			}
-			// Assume 2-digit years are in 1300-1399 range
-			if y < 100 {
-				year = 1300 + y
-			} else {
-				year = y
-			}
-			return nil
-		}},
-		{"MMMM", 0, func(s string) error {
-			m := GetMonthFromPersianName(s)
-			if m == 0 {
-				return fmt.Errorf("unknown Persian month name: %s", s)
-			}
-			month = m
-			return nil
-		}},
-		{"MMM", 0, func(s string) error {
-			m := GetMonthFromEnglishName(s)
-			if m == 0 {
-				return fmt.Errorf("unknown English month name: %s", s)
-			}
-			month = m
-			return nil
-		}},
-		{"MM", 2, func(s string) error {
-			month, err = strconv.Atoi(s)
-			return err
-		}},
-		{"M", 0, func(s string) error {
-			month, err = strconv.Atoi(s)
-			return err
-		}},
-		{"dd", 2, func(s string) error {
-			day, err = strconv.Atoi(s)
-			return err
-		}},
-		{"d", 0, func(s string) error {
-			day, err = strconv.Atoi(s)
-			return err
-		}},
-	}
-
-	layoutIdx := 0
-	valueIdx := 0
-
-	for layoutIdx < len(layout) && valueIdx < len(value) {
-		matched := false
-
-		for _, token := range tokens {
-			if strings.HasPrefix(layout[layoutIdx:], token.token) {
-				var tokenValue string
-
-				if token.length > 0 {
-					if valueIdx+token.length > len(value) {
-						return JalaliDate{}, fmt.Errorf("%w: insufficient characters for token %s", ErrParseFailure, token.token)
-					}
-					tokenValue = value[valueIdx : valueIdx+token.length]
-					valueIdx += token.length
-				} else {
-					if token.token == "MMMM" {
-						found := false
-						for m := 1; m <= 12; m++ {
-							monthName := GetMonthNamePersian(m)
-							if strings.HasPrefix(value[valueIdx:], monthName) {
-								tokenValue = monthName
-								valueIdx += len(monthName)
-								found = true
-								break
-							}
-						}
-						if !found {
-							return JalaliDate{}, fmt.Errorf("%w: could not match Persian month name", ErrParseFailure)
-						}
-					} else if token.token == "MMM" {
-						found := false
-						for m := 1; m <= 12; m++ {
-							monthName := GetMonthNameEnglish(m)
-							if len(value[valueIdx:]) >= len(monthName) &&
-								equalFold(value[valueIdx:valueIdx+len(monthName)], monthName) {
-								tokenValue = monthName
-								valueIdx += len(monthName)
-								found = true
-								break
-							}
-						}
-						if !found {
-							return JalaliDate{}, fmt.Errorf("%w: could not match English month name", ErrParseFailure)
-						}
-					} else {
-						// Single-digit number (M or d)
-						endIdx := valueIdx
-						for endIdx < len(value) && endIdx < valueIdx+2 && value[endIdx] >= '0' && value[endIdx] <= '9' {
-							endIdx++
-						}
-						if endIdx == valueIdx {
-							return JalaliDate{}, fmt.Errorf("%w: expected digit for token %s", ErrParseFailure, token.token)
-						}
-						tokenValue = value[valueIdx:endIdx]
-						valueIdx = endIdx
-					}
-				}
-
-				if err := token.setter(tokenValue); err != nil {
-					return JalaliDate{}, fmt.Errorf("%w: %v", ErrParseFailure, err)
-				}
-
-				layoutIdx += len(token.token)
-				matched = true
-				break
-			}
+	// Clock supplies the current date for the sliding window;
+	// CurrentClock() if nil
+	Clock Clock
+
+	// Lenient accepts values that differ from the layout in padding,
+	// whitespace and separators: numbers may have fewer digits than their
+	// token, whitespace is ignored around fields, and any of - / . ,
+	// matches any other
+	Lenient bool
+}
+
+// ParseWith parses a date string according to the given layout and options
+//
+//	j, err := persiancal.ParseWith("yy/MM/dd", "05/01/01",
+//		persiancal.ParseOptions{PivotYear: 1350}) // 1405/01/01
+//	j, err = persiancal.ParseWith(persiancal.LayoutSlash, " 1404 / 8-4 ",
+//		persiancal.ParseOptions{Lenient: true}) // 1404/08/04
+func ParseWith(layout, value string, opts ParseOptions) (JalaliDate, error) {
+	f, err := parseFields(layout, value, dateTokens, opts)
+	if err != nil {
+		return JalaliDate{}, err
+	}
+
+	j := JalaliDate{Year: f.year, Month: f.month, Day: f.day}
+	if err := j.Validate(); err != nil {
+		return JalaliDate{}, fmt.Errorf("%w: %v", ErrInvalidDate, err)
+	}
+	if f.hasWeekday && f.weekday != j.DayOfWeek() {
+		return JalaliDate{}, fmt.Errorf("%w: %s is a %s, not a %s", ErrInvalidDate, j, j.DayOfWeek(), f.weekday)
+	}
+
+	return j, nil
+}
+
+// expandYear resolves a two-digit year to a full year of cal
+func (o ParseOptions) expandYear(yy int, cal Calendar) int {
+	start := o.PivotYear
+	if start == 0 {
+		clock := o.Clock
+		if clock == nil {
+			clock = CurrentClock()
 		}
+		year, _, _ := cal.FromDayNumber(DayNumberOf(clock.Now().In(TehranLocation())))
+		start = year - 80
+	}
+	return start + floorMod(yy-start, 100)
+}
 
-		if !matched {
-			if layoutIdx < len(layout) && valueIdx < len(value) {
-				if layout[layoutIdx] != value[valueIdx] {
-					return JalaliDate{}, fmt.Errorf("%w: expected '%c' but got '%c'", ErrParseFailure, layout[layoutIdx], value[valueIdx])
-				}
-				layoutIdx++
-				valueIdx++
+// dateFields holds the values read from a string by parseFields
+type dateFields struct {
+	year       int
+	month      int
+	day        int
+	quarter    int
+	weekday    time.Weekday
+	hasWeekday bool
+}
+
+// parser reads the fields of value according to layout
+type parser struct {
+	layout  string
+	value   string   // value as given, for error reporting
+	latin   string   // value with Persian digits replaced by Latin digits
+	offsets []int    // offsets[i] is the offset in value of latin[i]
+	li, vi  int      // current positions in layout and latin
+	cal     Calendar // calendar of month names and two-digit years
+	opts    ParseOptions
+	fields  dateFields
+}
+
+// parseFields parses value according to layout, recognising the given
+// tokens, and returns the fields it read without validating them.
+// Errors are of type *ParseError.
+func parseFields(layout, value string, tokens []string, opts ParseOptions) (dateFields, error) {
+	return parseFieldsIn(layout, value, tokens, SolarHijriCalendar, opts)
+}
+
+// parseFieldsIn is parseFields for a date written in cal
+func parseFieldsIn(layout, value string, tokens []string, cal Calendar, opts ParseOptions) (dateFields, error) {
+	p := newParser(layout, value)
+	p.cal = cal
+	p.opts = opts
+
+	for {
+		if opts.Lenient {
+			p.skipSpace()
+		}
+		if p.li >= len(p.layout) || p.vi >= len(p.latin) {
+			break
+		}
+
+		token := matchToken(p.layout[p.li:], tokens)
+		if token == "" {
+			if !p.literalMatches(p.layout[p.li], p.latin[p.vi]) {
+				return dateFields{}, p.errorf("", fmt.Sprintf("'%c'", p.layout[p.li]))
 			}
+			p.li++
+			p.vi++
+			continue
 		}
+
+		if err := p.parseToken(token); err != nil {
+			return dateFields{}, err
+		}
+		p.li += len(token)
 	}
 
-	if layoutIdx < len(layout) || valueIdx < len(value) {
-		return JalaliDate{}, fmt.Errorf("%w: layout and value length mismatch", ErrParseFailure)
+	if p.vi < len(p.latin) {
+		return dateFields{}, p.errorf("", "end of input")
+	}
+	if p.li < len(p.layout) {
+		token := matchToken(p.layout[p.li:], tokens)
+		if token == "" {
+			return dateFields{}, p.errorf("", fmt.Sprintf("'%c'", p.layout[p.li]))
+		}
+		return dateFields{}, p.errorf(token, tokenExpectation(token))
 	}
 
-	j := JalaliDate{Year: year, Month: month, Day: day}
-	if err := j.Validate(); err != nil {
-		return JalaliDate{}, fmt.Errorf("%w: %v", ErrInvalidDate, err)
+	return p.fields, nil
+}
+
+// newParser returns a parser positioned at the start of layout and value
+func newParser(layout, value string) *parser {
+	p := &parser{layout: layout, value: value}
+	latin := make([]byte, 0, len(value))
+	for i, r := range value {
+		if isBidiControl(r) {
+			continue
+		}
+		if l, ok := latinDigits[r]; ok {
+			latin = append(latin, byte(l))
+			p.offsets = append(p.offsets, i)
+			continue
+		}
+		for k := range utf8.RuneLen(r) {
+			latin = append(latin, value[i+k])
+			p.offsets = append(p.offsets, i+k)
+		}
 	}
+	p.latin = string(latin)
+	p.offsets = append(p.offsets, len(value))
+	return p
+}
 
-	return j, nil
+// skipSpace skips whitespace in both layout and value
+func (p *parser) skipSpace() {
+	for p.li < len(p.layout) && isSpace(p.layout[p.li]) {
+		p.li++
+	}
+	for p.vi < len(p.latin) && isSpace(p.latin[p.vi]) {
+		p.vi++
+	}
+}
+
+// literalMatches reports whether the layout character l matches the value
+// character v. In lenient mode all separators match each other.
+func (p *parser) literalMatches(l, v byte) bool {
+	return l == v || (p.opts.Lenient && isSeparator(l) && isSeparator(v))
+}
+
+// isSpace reports whether c is ASCII whitespace
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// isSeparator reports whether c is a date separator
+func isSeparator(c byte) bool {
+	return c == '-' || c == '/' || c == '.' || c == ','
+}
+
+// errorf returns a *ParseError for the character at the current position
+func (p *parser) errorf(token, expected string) *ParseError {
+	return p.errorAt(p.vi, token, expected)
+}
+
+// errorAt returns a *ParseError for the character at position i of latin
+func (p *parser) errorAt(i int, token, expected string) *ParseError {
+	offset := p.offsets[i]
+	got := "end of input"
+	if offset < len(p.value) {
+		r, _ := utf8.DecodeRuneInString(p.value[offset:])
+		got = fmt.Sprintf("'%c'", r)
+	}
+	return &ParseError{
+		Layout:   p.layout,
+		Value:    p.value,
+		Offset:   offset,
+		Token:    token,
+		Expected: expected,
+		Got:      got,
+		Digits:   DetectDigits(p.value),
+		Err:      ErrParseFailure,
+	}
+}
+
+// tokenExpectation describes what a token matches, for error messages
+func tokenExpectation(token string) string {
+	switch token {
+	case "yyyy":
+		return "4 digits"
+	case "yy", "MM", "dd":
+		return "2 digits"
+	case "MMMM", "MMM", "%B", "%b", "%h":
+		return "month name"
+	case "EEEE", "EEE", "%A", "%a":
+		return "weekday name"
+	case "QQQ":
+		return "'Q'"
+	case "a", "%p":
+		return "AM or PM"
+	}
+	// LDML pattern letters
+	switch {
+	case token[0] == 'G':
+		return "era"
+	case token[0] == 'E':
+		return "weekday name"
+	case strings.HasPrefix(token, "MMM"), strings.HasPrefix(token, "LLL"):
+		return "month name"
+	}
+	return "digit"
+}
+
+// parseToken reads the value of a single token at the current position
+func (p *parser) parseToken(token string) error {
+	var err error
+	switch token {
+	case "yyyy":
+		p.fields.year, err = p.number(token, 4, 4)
+	case "yy":
+		var y int
+		y, err = p.number(token, 2, 2)
+		p.fields.year = p.opts.expandYear(y, p.cal)
+	case "MMMM":
+		p.fields.month, err = p.name(token, p.monthNames(false), false)
+	case "MMM":
+		p.fields.month, err = p.name(token, p.monthNames(true), true)
+	case "EEEE", "EEE":
+		var wd int
+		if token == "EEEE" {
+			wd, err = p.name(token, weekdayNameList(GetWeekdayNamePersian), false)
+		} else {
+			wd, err = p.name(token, weekdayNameList(GetWeekdayNameEnglish), true)
+		}
+		p.fields.weekday, p.fields.hasWeekday = time.Weekday(wd), true
+	case "MM":
+		p.fields.month, err = p.number(token, 2, 2)
+	case "M":
+		p.fields.month, err = p.number(token, 1, 2)
+	case "dd":
+		p.fields.day, err = p.number(token, 2, 2)
+	case "d":
+		p.fields.day, err = p.number(token, 1, 2)
+	case "QQQ":
+		if p.vi >= len(p.latin) || (p.latin[p.vi] != 'Q' && p.latin[p.vi] != 'q') {
+			return p.errorf(token, tokenExpectation(token))
+		}
+		p.vi++
+		p.fields.quarter, err = p.number(token, 1, 1)
+	case "Q":
+		p.fields.quarter, err = p.number(token, 1, 1)
+	}
+	return err
+}
+
+// number reads between minDigits and maxDigits decimal digits
+func (p *parser) number(token string, minDigits, maxDigits int) (int, error) {
+	if p.opts.Lenient {
+		minDigits = 1
+	}
+	end := p.vi
+	for end < len(p.latin) && end < p.vi+maxDigits && p.latin[end] >= '0' && p.latin[end] <= '9' {
+		end++
+	}
+	if end-p.vi < minDigits {
+		if minDigits == maxDigits {
+			return 0, p.errorAt(end, token, fmt.Sprintf("%d digits", minDigits))
+		}
+		return 0, p.errorAt(end, token, "digit")
+	}
+	n, _ := strconv.Atoi(p.latin[p.vi:end])
+	p.vi = end
+	return n, nil
+}
+
+// name reads the longest of names at the current position and returns its
+// index. Empty names never match.
+func (p *parser) name(token string, names []string, foldCase bool) (int, error) {
+	rest := p.latin[p.vi:]
+	best, bestLen := -1, 0
+	for i, n := range names {
+		if len(n) <= bestLen || len(rest) < len(n) {
+			continue
+		}
+		if rest[:len(n)] == n || (foldCase && equalFold(rest[:len(n)], n)) {
+			best, bestLen = i, len(n)
+		}
+	}
+	if best < 0 {
+		return 0, p.errorf(token, tokenExpectation(token))
+	}
+	p.vi += bestLen
+	return best, nil
+}
+
+// monthNames returns the Persian or English month names of the parser's
+// calendar, indexed from 1
+func (p *parser) monthNames(english bool) []string {
+	return monthNames(func(m int) string {
+		if english {
+			return p.cal.MonthName(m).English
+		}
+		return p.cal.MonthName(m).Persian
+	})
+}
+
+// monthNames returns the names of the 12 months, indexed from 1
+func monthNames(name func(int) string) []string {
+	names := make([]string, 13)
+	for m := 1; m <= 12; m++ {
+		names[m] = name(m)
+	}
+	return names
+}
+
+// weekdayNameList returns the names of the weekdays, indexed by time.Weekday
+func weekdayNameList(name func(time.Weekday) string) []string {
+	names := make([]string, 7)
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		names[wd] = name(wd)
+	}
+	return names
 }
 
 // MustParse parses a date string and panics if parsing fails
@@ -228,4 +487,10 @@ const (
 
 	// LayoutShort is the short format: yy/MM/dd
 	LayoutShort = "yy/MM/dd"
+
+	// LayoutFull is the full format with weekday and month name: EEEE d MMMM yyyy
+	LayoutFull = "EEEE d MMMM yyyy"
+
+	// LayoutFullEnglish is the full format with English names: EEE, d MMM yyyy
+	LayoutFullEnglish = "EEE, d MMM yyyy"
 )
@@ -0,0 +1,185 @@
+package persiancal
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// tehranClock returns a clock fixed at the given wall time in Tehran
+func tehranClock(year int, month time.Month, day, hour, min int) Clock {
+	return FixedClock(time.Date(year, month, day, hour, min, 0, 0, TehranLocation()))
+}
+
+func TestParseTwoDigitYear(t *testing.T) {
+	in1404 := tehranClock(2025, 10, 26, 12, 0)
+	// The last minute of 1403 and the first of 1404: the window moves by a
+	// year at Nowruz in Tehran
+	end1403 := tehranClock(2025, 3, 20, 23, 59)
+	start1404 := tehranClock(2025, 3, 21, 0, 0)
+
+	tests := []struct {
+		value string
+		opts  ParseOptions
+		want  int
+	}{
+		// Sliding window from 80 years before to 19 years after today
+		{"05", ParseOptions{Clock: in1404}, 1405},
+		{"50", ParseOptions{Clock: in1404}, 1350},
+		{"23", ParseOptions{Clock: in1404}, 1423},
+		{"24", ParseOptions{Clock: in1404}, 1324},
+		{"23", ParseOptions{Clock: end1403}, 1323},
+		{"22", ParseOptions{Clock: end1403}, 1422},
+		{"23", ParseOptions{Clock: start1404}, 1423},
+
+		// A pivot year ignores the clock
+		{"50", ParseOptions{PivotYear: 1350, Clock: in1404}, 1350},
+		{"49", ParseOptions{PivotYear: 1350, Clock: in1404}, 1449},
+		{"99", ParseOptions{PivotYear: 1350}, 1399},
+		{"00", ParseOptions{PivotYear: 1350}, 1400},
+		{"00", ParseOptions{PivotYear: 1300}, 1300},
+		{"99", ParseOptions{PivotYear: 1300}, 1399},
+		{"99", ParseOptions{PivotYear: 1399}, 1399},
+		{"98", ParseOptions{PivotYear: 1399}, 1498},
+		{"05", ParseOptions{PivotYear: 1401}, 1405},
+		{"00", ParseOptions{PivotYear: 1401}, 1500},
+	}
+	for _, tt := range tests {
+		j, err := ParseWith("yy/MM/dd", tt.value+"/01/01", tt.opts)
+		if err != nil || j.Year != tt.want {
+			t.Errorf("ParseWith(%q, %+v) = %s, %v; want year %d", tt.value, tt.opts, j, err, tt.want)
+		}
+	}
+}
+
+func TestParseTwoDigitYearUsesPackageClock(t *testing.T) {
+	defer SetClock(SetClock(tehranClock(2025, 3, 20, 23, 59)))
+
+	if j, err := Parse(LayoutShort, "23/01/01"); err != nil || j.Year != 1323 {
+		t.Errorf("Parse(23/01/01) in 1403 = %s, %v; want 1323/01/01", j, err)
+	}
+	if ym, err := ParseYearMonth("yy/MM", "23/01"); err != nil || ym.Year != 1323 {
+		t.Errorf("ParseYearMonth(23/01) in 1403 = %v, %v; want 1323/01", ym, err)
+	}
+
+	SetClock(tehranClock(2025, 3, 21, 0, 0))
+	if j, err := Parse(LayoutShort, "23/01/01"); err != nil || j.Year != 1423 {
+		t.Errorf("Parse(23/01/01) in 1404 = %s, %v; want 1423/01/01", j, err)
+	}
+	if tm, err := Strptime("%y/%m/%d", "23/01/01", time.UTC); err != nil || FromGregorianDate(tm).Year != 1423 {
+		t.Errorf("Strptime(23/01/01) in 1404 = %s, %v; want 1423/01/01", tm, err)
+	}
+	if tm, err := ParseLDML("yy/M/d", "23/1/1", LocaleEnglish, time.UTC); err != nil || FromGregorianDate(tm).Year != 1423 {
+		t.Errorf("ParseLDML(23/1/1) in 1404 = %s, %v; want 1423/01/01", tm, err)
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	j := JalaliDate{1404, 8, 4}
+	tests := []struct {
+		layout, value string
+	}{
+		{LayoutSlash, " 1404 / 8-4 "},
+		{LayoutSlash, "1404/8/4"},
+		{LayoutSlash, "1404.08.04"},
+		{LayoutSlash, "1404,8,4"},
+		{LayoutISO, "1404/08/04"},
+		{LayoutISO, "\t1404 -08- 4\n"},
+		{LayoutDot, "۱۴۰۴ / ۸ / ۴"},
+		{LayoutLong, "4   آبان 1404"},
+		{"yyyy/MM/dd", "1404/08/4"},
+		{"dd MMM yyyy", " 4 aban  1404 "},
+	}
+	for _, tt := range tests {
+		got, err := ParseWith(tt.layout, tt.value, ParseOptions{Lenient: true})
+		if err != nil || got != j {
+			t.Errorf("ParseWith(%q, %q, lenient) = %s, %v; want %s", tt.layout, tt.value, got, err, j)
+		}
+		if _, err := Parse(tt.layout, tt.value); !errors.Is(err, ErrParseFailure) {
+			t.Errorf("Parse(%q, %q) error = %v, want ErrParseFailure", tt.layout, tt.value, err)
+		}
+	}
+}
+
+func TestParseLenientErrors(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		want          error
+	}{
+		{LayoutSlash, "1404x08x04", ErrParseFailure},
+		{LayoutSlash, "1404//08/04", ErrParseFailure},
+		{LayoutSlash, "1404/08", ErrParseFailure},
+		{LayoutSlash, "1404/08/04/1", ErrParseFailure},
+		{LayoutSlash, "1404/008/04", ErrParseFailure},
+		{LayoutSlash, "1404/13/04", ErrInvalidDate},
+		{LayoutSlash, "1404/12/30", ErrInvalidDate},
+	}
+	for _, tt := range tests {
+		if _, err := ParseWith(tt.layout, tt.value, ParseOptions{Lenient: true}); !errors.Is(err, tt.want) {
+			t.Errorf("ParseWith(%q, %q, lenient) error = %v, want %v", tt.layout, tt.value, err, tt.want)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		want          ParseError
+	}{
+		// Persian digits are two bytes each, so the '-' is at byte 13
+		{LayoutSlash, "۱۴۰۴/۰۸-۰۴", ParseError{Offset: 13, Expected: "'/'", Got: "'-'", Digits: DigitsPersian}},
+		{LayoutSlash, "1404/8/04", ParseError{Offset: 6, Token: "MM", Expected: "2 digits", Got: "'/'", Digits: DigitsLatin}},
+		{LayoutSlash, "1404/08", ParseError{Offset: 7, Expected: "'/'", Got: "end of input", Digits: DigitsLatin}},
+		{LayoutSlash, "1404/08/04x", ParseError{Offset: 10, Expected: "end of input", Got: "'x'", Digits: DigitsLatin}},
+		{LayoutSlash, "۱۴۰۴/8-۰۴", ParseError{Offset: 10, Token: "MM", Expected: "2 digits", Got: "'-'", Digits: DigitsMixed}},
+		{LayoutSlash, "١٤٠٤/٠٨/٠٤", ParseError{Offset: 0, Token: "yyyy", Expected: "4 digits", Got: "'١'", Digits: DigitsArabic}},
+		{LayoutLongEnglish, "04 Abn 1404", ParseError{Offset: 3, Token: "MMM", Expected: "month name", Got: "'A'", Digits: DigitsLatin}},
+		{"EEEE dd MMMM yyyy", "یکشنبه ۰۴ ابان ۱۴۰۴", ParseError{Offset: 18, Token: "MMMM", Expected: "month name", Got: "'ا'", Digits: DigitsPersian}},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.layout, tt.value)
+		if !errors.Is(err, ErrParseFailure) {
+			t.Errorf("Parse(%q, %q) error = %v, want ErrParseFailure", tt.layout, tt.value, err)
+			continue
+		}
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("Parse(%q, %q) error = %T, want *ParseError", tt.layout, tt.value, err)
+			continue
+		}
+		want := tt.want
+		want.Layout, want.Value, want.Err = tt.layout, tt.value, ErrParseFailure
+		if *pe != want {
+			t.Errorf("Parse(%q, %q) error = %+v, want %+v", tt.layout, tt.value, *pe, want)
+		}
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	tests := []struct {
+		layout, value, want string
+	}{
+		{LayoutSlash, "۱۴۰۴/۰۸-۰۴", "failed to parse date: expected '/' but got '-' at offset 13"},
+		{LayoutSlash, "1404/8/04", "failed to parse date: expected 2 digits for token MM but got '/' at offset 6"},
+		{LayoutSlash, "١٤٠٤/٠٨/٠٤", "failed to parse date: expected 4 digits for token yyyy but got '١' at offset 0" +
+			" (value has Arabic-Indic digits; use Persian or Latin digits)"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.layout, tt.value)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q, %q) error = %v, want %q", tt.layout, tt.value, err, tt.want)
+		}
+	}
+
+	// Other parsers report the same structured error
+	for _, err := range []error{
+		func() error { _, err := Strptime("%Y/%m/%d", "1404-08-04", nil); return err }(),
+		func() error { _, err := ParseLDML("y/M/d", "1404-8-4", LocaleEnglish, nil); return err }(),
+		func() error { _, err := ParseYearMonth("yyyy/MM", "1404-08"); return err }(),
+	} {
+		var pe *ParseError
+		if !errors.As(err, &pe) || pe.Offset != 4 || pe.Got != "'-'" {
+			t.Errorf("error = %v, want a *ParseError at offset 4", err)
+		}
+	}
+}
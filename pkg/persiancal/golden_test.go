@@ -0,0 +1,50 @@
+package persiancal
+
+import (
+	"bufio"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// readGolden reads a golden file of tab-separated rows. Comments and blank
+// lines are kept in lines so that the file can be rewritten unchanged; rows
+// maps the index of each other line to its columns.
+func readGolden(t *testing.T, path string, columns int) (lines []string, rows map[int][]string) {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	rows = make(map[int][]string)
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		lines = append(lines, line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		cols := strings.Split(line, "\t")
+		if len(cols) != columns {
+			t.Fatalf("%s:%d: want %d tab-separated columns, got %d", path, len(lines), columns, len(cols))
+		}
+		rows[len(lines)-1] = cols
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return lines, rows
+}
+
+// writeGolden rewrites a golden file read by readGolden
+func writeGolden(t *testing.T, path string, lines []string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
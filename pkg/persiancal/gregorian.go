@@ -0,0 +1,60 @@
+package persiancal
+
+import (
+	"fmt"
+	"time"
+)
+
+// FormatGregorian formats the Gregorian date of t with the tokens of
+// JalaliDate.Format, so the same layout can be used for both calendars.
+// MMMM is the Persian name of the Gregorian month (e.g., اکتبر) and MMM its
+// English name (e.g., October).
+//
+//	persiancal.FormatGregorian(t, "EEEE d MMMM yyyy") // یکشنبه 26 اکتبر 2025
+func FormatGregorian(t time.Time, layout string) string {
+	year, month, day := t.Date()
+	return formatLayout(layout, dateTokens, func(token string) string {
+		switch token {
+		case "MMMM":
+			return westernMonthNames[month].Persian
+		case "MMM":
+			return westernMonthNames[month].English
+		case "EEEE":
+			return GetWeekdayNamePersian(t.Weekday())
+		case "EEE":
+			return GetWeekdayNameEnglish(t.Weekday())
+		}
+		return formatField(token, year, int(month), day)
+	})
+}
+
+// FormatGregorianPersian formats the Gregorian date of t with Persian digits
+func FormatGregorianPersian(t time.Time, layout string) string {
+	return ToPersianDigits(FormatGregorian(t, layout))
+}
+
+// ParseGregorian parses a Gregorian date with the tokens of Parse and
+// returns it at midnight UTC. Month names may be Persian (MMMM) or English
+// (MMM) Gregorian month names.
+func ParseGregorian(layout, value string) (time.Time, error) {
+	return ParseGregorianWith(layout, value, ParseOptions{})
+}
+
+// ParseGregorianWith parses a Gregorian date according to the given layout
+// and options. Two-digit years are placed in a window of Gregorian years.
+func ParseGregorianWith(layout, value string, opts ParseOptions) (time.Time, error) {
+	f, err := parseFieldsIn(layout, value, dateTokens, GregorianCalendar, opts)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	n, err := GregorianCalendar.ToDayNumber(f.year, f.month, f.day)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidDate, err)
+	}
+	t := n.Time(time.UTC)
+	if f.hasWeekday && f.weekday != t.Weekday() {
+		return time.Time{}, fmt.Errorf("%w: %s is a %s, not a %s", ErrInvalidDate, t.Format(time.DateOnly), t.Weekday(), f.weekday)
+	}
+	return t, nil
+}
@@ -0,0 +1,150 @@
+package persiancal
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFormatGregorian(t *testing.T) {
+	g := time.Date(2025, 10, 26, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		layout, want, persian string
+	}{
+		{"yyyy-MM-dd", "2025-10-26", "۲۰۲۵-۱۰-۲۶"},
+		{"EEEE d MMMM yyyy", "یکشنبه 26 اکتبر 2025", "یکشنبه ۲۶ اکتبر ۲۰۲۵"},
+		{"EEE, d MMM yy", "Sunday, 26 October 25", "Sunday, ۲۶ October ۲۵"},
+		{"dd/MM/yyyy", "26/10/2025", "۲۶/۱۰/۲۰۲۵"},
+		{"M/d", "10/26", "۱۰/۲۶"},
+	}
+	for _, tt := range tests {
+		if got := FormatGregorian(g, tt.layout); got != tt.want {
+			t.Errorf("FormatGregorian(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+		if got := FormatGregorianPersian(g, tt.layout); got != tt.persian {
+			t.Errorf("FormatGregorianPersian(%q) = %q, want %q", tt.layout, got, tt.persian)
+		}
+	}
+
+	// The date is that of t's location
+	late := time.Date(2025, 10, 26, 22, 0, 0, 0, time.UTC).In(TehranLocation())
+	if got := FormatGregorian(late, "yyyy-MM-dd"); got != "2025-10-27" {
+		t.Errorf("FormatGregorian(22:00 UTC in Tehran) = %q, want 2025-10-27", got)
+	}
+}
+
+func TestGregorianMonthNames(t *testing.T) {
+	persian := []string{"ژانویه", "فوریه", "مارس", "آوریل", "مه", "ژوئن",
+		"ژوئیه", "اوت", "سپتامبر", "اکتبر", "نوامبر", "دسامبر"}
+	for i, name := range persian {
+		g := time.Date(2025, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC)
+		if got := FormatGregorian(g, "MMMM"); got != name {
+			t.Errorf("FormatGregorian(%s, MMMM) = %q, want %q", g.Month(), got, name)
+		}
+		if got := FormatGregorian(g, "MMM"); got != g.Month().String() {
+			t.Errorf("FormatGregorian(%s, MMM) = %q, want %q", g.Month(), got, g.Month())
+		}
+	}
+}
+
+func TestParseGregorianRoundTrip(t *testing.T) {
+	layouts := []string{
+		"yyyy-MM-dd",
+		"d MMMM yyyy",
+		"EEEE d MMMM yyyy",
+		"EEE, d MMM yyyy",
+		"dd/MM/yyyy",
+	}
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for d := start; d.Year() < 2026; d = d.AddDate(0, 0, 1) {
+		for _, layout := range layouts {
+			for _, s := range []string{FormatGregorian(d, layout), FormatGregorianPersian(d, layout)} {
+				got, err := ParseGregorian(layout, s)
+				if err != nil || !got.Equal(d) {
+					t.Fatalf("ParseGregorian(%q, %q) = %v, %v; want %v", layout, s, got, err, d)
+				}
+			}
+		}
+	}
+}
+
+func TestParseGregorian(t *testing.T) {
+	want := time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		layout, value string
+	}{
+		{"d MMMM yyyy", "26 اکتبر 2025"},
+		{"d MMMM yyyy", "۲۶ اکتبر ۲۰۲۵"},
+		{"d MMM yyyy", "26 October 2025"},
+		{"d MMM yyyy", "26 october 2025"},
+		{"EEEE yyyy/MM/dd", "یکشنبه ۲۰۲۵/۱۰/۲۶"},
+		{"yy-MM-dd", "25-10-26"},
+	}
+	for _, tt := range tests {
+		got, err := ParseGregorian(tt.layout, tt.value)
+		if err != nil || !got.Equal(want) || got.Location() != time.UTC {
+			t.Errorf("ParseGregorian(%q, %q) = %v, %v; want %v", tt.layout, tt.value, got, err, want)
+		}
+	}
+
+	// Leap days follow the Gregorian calendar
+	if got, err := ParseGregorian("d MMMM yyyy", "29 فوریه 2024"); err != nil || !got.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ParseGregorian(29 فوریه 2024) = %v, %v; want 2024-02-29", got, err)
+	}
+}
+
+func TestParseGregorianErrors(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		want          error
+	}{
+		{"d MMMM yyyy", "29 فوریه 2025", ErrInvalidDate},
+		{"yyyy-MM-dd", "2025-13-01", ErrInvalidDate},
+		{"EEEE d MMMM yyyy", "شنبه 26 اکتبر 2025", ErrInvalidDate},
+		// MMMM is the Persian name and MMM the English one
+		{"d MMMM yyyy", "26 October 2025", ErrParseFailure},
+		{"d MMM yyyy", "26 اکتبر 2025", ErrParseFailure},
+		// Jalali month names are not Gregorian ones
+		{"d MMMM yyyy", "26 آبان 2025", ErrParseFailure},
+		{"yyyy-MM-dd", "2025/10/26", ErrParseFailure},
+	}
+	for _, tt := range tests {
+		if got, err := ParseGregorian(tt.layout, tt.value); !errors.Is(err, tt.want) {
+			t.Errorf("ParseGregorian(%q, %q) = %v, %v; want %v", tt.layout, tt.value, got, err, tt.want)
+		}
+	}
+
+	var pe *ParseError
+	if _, err := ParseGregorian("d MMM yyyy", "26 Oct 2025"); !errors.As(err, &pe) || pe.Token != "MMM" || pe.Offset != 3 {
+		t.Errorf("ParseGregorian(26 Oct 2025) error = %#v, want a ParseError at offset 3 for MMM", err)
+	}
+}
+
+func TestParseGregorianTwoDigitYear(t *testing.T) {
+	tests := []struct {
+		pivot int
+		value string
+		want  int
+	}{
+		{1950, "49-01-01", 2049},
+		{1950, "50-01-01", 1950},
+		{1950, "99-12-31", 1999},
+		{2000, "00-03-01", 2000},
+		{2000, "99-03-01", 2099},
+	}
+	for _, tt := range tests {
+		got, err := ParseGregorianWith("yy-MM-dd", tt.value, ParseOptions{PivotYear: tt.pivot})
+		if err != nil || got.Year() != tt.want {
+			t.Errorf("ParseGregorianWith(%q, pivot %d) = %v, %v; want year %d", tt.value, tt.pivot, got, err, tt.want)
+		}
+	}
+
+	// Without a pivot, the window starts 80 Gregorian years before today:
+	// 1945-2044 in 2025
+	defer SetClock(SetClock(FixedClock(time.Date(2025, 10, 26, 12, 0, 0, 0, time.UTC))))
+	for value, want := range map[string]int{"44-12-31": 2044, "45-01-01": 1945, "99-01-01": 1999} {
+		if got, err := ParseGregorian("yy-MM-dd", value); err != nil || got.Year() != want {
+			t.Errorf("ParseGregorian(%q) = %v, %v; want year %d", value, got, err, want)
+		}
+	}
+}
@@ -0,0 +1,384 @@
+package persiancal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LDML patterns follow Unicode Technical Standard #35, as used by ICU and
+// by Intl.DateTimeFormat with the @calendar=persian locale extension.
+// Supported pattern letters:
+//   - G: era (GGGG wide, GGGGG narrow)
+//   - y: year (yy two digits, yyy... zero-padded)
+//   - M, L: month (M, MM numeric; MMM abbreviated; MMMM wide; MMMMM narrow)
+//   - d: day of month; D: day of year
+//   - E: weekday (E-EEE abbreviated; EEEE wide; EEEEE narrow; EEEEEE short)
+//   - a: AM/PM
+//   - H: hour 0-23; h: hour 1-12; K: hour 0-11; k: hour 1-24
+//   - m: minute; s: second
+//
+// Text in single quotes is copied literally and '' is a single quote. Any
+// other ASCII letter is reserved and makes the pattern invalid. Numbers use
+// Persian digits in the Persian locale.
+
+// ldmlField is a run of one pattern letter, or literal text if letter is 0
+type ldmlField struct {
+	letter  byte
+	count   int
+	literal string
+}
+
+// token returns the pattern letters of the field, e.g. "MMMM"
+func (f ldmlField) token() string {
+	return strings.Repeat(string(f.letter), f.count)
+}
+
+// ldmlLetters are the supported pattern letters
+const ldmlLetters = "GyMLdDEaHhKkms"
+
+// compileLDML splits an LDML pattern into fields
+func compileLDML(pattern string) ([]ldmlField, error) {
+	var fields []ldmlField
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			fields = append(fields, ldmlField{literal: lit.String()})
+			lit.Reset()
+		}
+	}
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'' && i+1 < len(pattern) && pattern[i+1] == '\'':
+			lit.WriteByte('\'')
+			i += 2
+		case c == '\'':
+			// Quoted text runs to the next lone quote
+			i++
+			for {
+				if i >= len(pattern) {
+					return nil, fmt.Errorf("%w: unterminated quote in %q", ErrInvalidLayout, pattern)
+				}
+				if pattern[i] == '\'' {
+					if i+1 < len(pattern) && pattern[i+1] == '\'' {
+						lit.WriteByte('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				lit.WriteByte(pattern[i])
+				i++
+			}
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			if !strings.ContainsRune(ldmlLetters, rune(c)) {
+				return nil, fmt.Errorf("%w: unsupported pattern letter %q in %q", ErrInvalidLayout, c, pattern)
+			}
+			n := 1
+			for i+n < len(pattern) && pattern[i+n] == c {
+				n++
+			}
+			flush()
+			fields = append(fields, ldmlField{letter: c, count: n})
+			i += n
+		default:
+			lit.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	return fields, nil
+}
+
+// ldmlNames holds the names used by LDML patterns in one locale
+type ldmlNames struct {
+	era             [3]string // abbreviated, wide, narrow
+	dayPeriods      [2]string // AM, PM
+	monthsNarrow    []string  // 1-indexed
+	weekdaysAbbr    []string  // indexed by time.Weekday
+	weekdaysNarrow  []string
+	weekdaysShort   []string
+	months          func(int) string
+	weekdays        func(time.Weekday) string
+	foldCaseOnParse bool
+}
+
+// ldmlLocales holds the names for each supported locale
+var ldmlLocales = map[Locale]ldmlNames{
+	LocalePersian: {
+		era:            [3]string{"ه‍.ش.", "هجری شمسی", "ه‍.ش."},
+		dayPeriods:     [2]string{"قبل‌ازظهر", "بعدازظهر"},
+		monthsNarrow:   []string{"", "ف", "ا", "خ", "ت", "م", "ش", "م", "آ", "آ", "د", "ب", "ا"},
+		weekdaysAbbr:   weekdayNameList(GetWeekdayNamePersian),
+		weekdaysNarrow: []string{"ی", "د", "س", "چ", "پ", "ج", "ش"},
+		weekdaysShort:  []string{"۱ش", "۲ش", "۳ش", "۴ش", "۵ش", "ج", "ش"},
+		months:         GetMonthNamePersian,
+		weekdays:       GetWeekdayNamePersian,
+	},
+	LocaleEnglish: {
+		era:             [3]string{"AP", "AP", "AP"},
+		dayPeriods:      [2]string{"AM", "PM"},
+		monthsNarrow:    []string{"", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+		weekdaysAbbr:    []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		weekdaysNarrow:  []string{"S", "M", "T", "W", "T", "F", "S"},
+		weekdaysShort:   []string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+		months:          GetMonthNameEnglish,
+		weekdays:        GetWeekdayNameEnglish,
+		foldCaseOnParse: true,
+	},
+}
+
+// FormatLDML formats t, converted to the Jalali calendar in t's location,
+// with an LDML pattern. Returns an error wrapping ErrInvalidLayout for
+// malformed patterns.
+//
+//	s, err := persiancal.FormatLDML(t, "EEEE d MMMM y G، HH:mm", persiancal.LocalePersian)
+//	// یکشنبه ۴ آبان ۱۴۰۴ ه‍.ش.، ۱۴:۳۰
+func FormatLDML(t time.Time, pattern string, locale Locale) (string, error) {
+	fields, err := compileLDML(pattern)
+	if err != nil {
+		return "", err
+	}
+	j := FromGregorianDate(t)
+	names := ldmlLocales[locale.language()]
+
+	var b strings.Builder
+	for _, f := range fields {
+		if f.letter == 0 {
+			b.WriteString(f.literal)
+			continue
+		}
+		text, numeric := f.format(j, t, names)
+		if numeric {
+			text = locale.localize(text)
+		}
+		b.WriteString(text)
+	}
+	return b.String(), nil
+}
+
+// FormatLDML formats the date with an LDML pattern. Time fields are
+// formatted as midnight.
+func (j JalaliDate) FormatLDML(pattern string, locale Locale) (string, error) {
+	return FormatLDML(j.ToGregorian(), pattern, locale)
+}
+
+// format renders a pattern field and reports whether it is numeric
+func (f ldmlField) format(j JalaliDate, t time.Time, names ldmlNames) (string, bool) {
+	switch f.letter {
+	case 'G':
+		return names.era[widthIndex(f.count)], false
+	case 'y':
+		if f.count == 2 {
+			return fmt.Sprintf("%02d", floorMod(j.Year, 100)), true
+		}
+		return fmt.Sprintf("%0*d", f.count, j.Year), true
+	case 'M', 'L':
+		switch {
+		case f.count >= 5:
+			return names.monthsNarrow[j.Month], false
+		case f.count >= 3:
+			return names.months(j.Month), false
+		}
+		return fmt.Sprintf("%0*d", f.count, j.Month), true
+	case 'd':
+		return fmt.Sprintf("%0*d", f.count, j.Day), true
+	case 'D':
+		return fmt.Sprintf("%0*d", f.count, j.DayOfYear()), true
+	case 'E':
+		wd := j.DayOfWeek()
+		switch f.count {
+		case 4:
+			return names.weekdays(wd), false
+		case 5:
+			return names.weekdaysNarrow[wd], false
+		case 6:
+			return names.weekdaysShort[wd], false
+		}
+		return names.weekdaysAbbr[wd], false
+	case 'a':
+		return names.dayPeriods[t.Hour()/12], false
+	case 'H':
+		return fmt.Sprintf("%0*d", f.count, t.Hour()), true
+	case 'h':
+		return fmt.Sprintf("%0*d", f.count, (t.Hour()+11)%12+1), true
+	case 'K':
+		return fmt.Sprintf("%0*d", f.count, t.Hour()%12), true
+	case 'k':
+		return fmt.Sprintf("%0*d", f.count, (t.Hour()+23)%24+1), true
+	case 'm':
+		return fmt.Sprintf("%0*d", f.count, t.Minute()), true
+	case 's':
+		return fmt.Sprintf("%0*d", f.count, t.Second()), true
+	}
+	return "", false
+}
+
+// widthIndex maps the length of a G field to abbreviated, wide or narrow
+func widthIndex(count int) int {
+	switch {
+	case count == 4:
+		return 1
+	case count >= 5:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// ParseLDML parses a value formatted with an LDML pattern and returns the
+// time it describes in loc. A nil location is treated as UTC. Both Persian
+// and Latin digits are accepted, and two-digit years are resolved as by
+// Parse, from the current date. Narrow month names are ambiguous and cannot
+// be parsed. Errors for values that do not match the pattern are of type
+// *ParseError.
+func ParseLDML(pattern, value string, locale Locale, loc *time.Location) (time.Time, error) {
+	fields, err := compileLDML(pattern)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	names := ldmlLocales[locale.language()]
+	for _, f := range fields {
+		if (f.letter == 'M' || f.letter == 'L') && f.count >= 5 {
+			return time.Time{}, fmt.Errorf("%w: narrow month names in %q cannot be parsed", ErrInvalidLayout, pattern)
+		}
+	}
+
+	p := newParser(pattern, value)
+	v := timeFields{hour: -1}
+	for _, f := range fields {
+		if f.letter == 0 {
+			lit := ToLatinDigits(f.literal)
+			if !strings.HasPrefix(p.latin[p.vi:], lit) {
+				return time.Time{}, p.errorf("", fmt.Sprintf("%q", f.literal))
+			}
+			p.vi += len(lit)
+			continue
+		}
+		if err := v.parse(p, f, names); err != nil {
+			return time.Time{}, err
+		}
+	}
+	if p.vi < len(p.latin) {
+		return time.Time{}, p.errorf("", "end of input")
+	}
+
+	return v.time(loc)
+}
+
+// timeFields holds the fields read by ParseLDML and Strptime
+type timeFields struct {
+	dateFields
+	yearDay               int
+	hour, minute, second  int
+	hour12, pm, hasPeriod bool
+}
+
+// parse reads a single pattern field
+func (v *timeFields) parse(p *parser, f ldmlField, names ldmlNames) error {
+	token := f.token()
+	var err error
+	switch f.letter {
+	case 'G':
+		_, err = p.name(token, names.era[:], names.foldCaseOnParse)
+	case 'y':
+		switch f.count {
+		case 2:
+			var yy int
+			yy, err = p.number(token, 2, 2)
+			v.year = ParseOptions{}.expandYear(yy, SolarHijriCalendar)
+		default:
+			v.year, err = p.number(token, f.count, max(f.count, 4))
+		}
+	case 'M', 'L':
+		switch {
+		case f.count >= 3:
+			v.month, err = p.name(token, monthNames(names.months), names.foldCaseOnParse)
+		default:
+			v.month, err = p.number(token, f.count, 2)
+		}
+	case 'd':
+		v.day, err = p.number(token, f.count, 2)
+	case 'D':
+		v.yearDay, err = p.number(token, f.count, 3)
+	case 'E':
+		list := names.weekdaysAbbr
+		switch f.count {
+		case 4:
+			list = weekdayNameList(names.weekdays)
+		case 5:
+			// Narrow names are ambiguous, so they are not checked
+			_, err = p.name(token, names.weekdaysNarrow, names.foldCaseOnParse)
+			return err
+		case 6:
+			list = make([]string, len(names.weekdaysShort))
+			for i, n := range names.weekdaysShort {
+				list[i] = ToLatinDigits(n)
+			}
+		}
+		var wd int
+		wd, err = p.name(token, list, names.foldCaseOnParse)
+		v.weekday, v.hasWeekday = time.Weekday(wd), true
+	case 'a':
+		var i int
+		i, err = p.name(token, names.dayPeriods[:], names.foldCaseOnParse)
+		v.pm, v.hasPeriod = i == 1, true
+	case 'H', 'k':
+		v.hour, err = p.number(token, f.count, 2)
+		if f.letter == 'k' && v.hour == 24 {
+			v.hour = 0
+		}
+	case 'h', 'K':
+		v.hour, err = p.number(token, f.count, 2)
+		v.hour12 = true
+	case 'm':
+		v.minute, err = p.number(token, f.count, 2)
+	case 's':
+		v.second, err = p.number(token, f.count, 2)
+	}
+	return err
+}
+
+// time validates the values and builds the time they describe
+func (v *timeFields) time(loc *time.Location) (time.Time, error) {
+	// A day of year stands in for a missing month and day
+	if v.yearDay > 0 && v.month == 0 && v.day == 0 {
+		if v.yearDay > DaysInYear(v.year) {
+			return time.Time{}, fmt.Errorf("%w: year %d has no day %d", ErrInvalidDate, v.year, v.yearDay)
+		}
+		_, v.month, v.day = jdnToJalali(jalaliToJDN(v.year, 1, 1) + v.yearDay - 1)
+	}
+	if v.month == 0 {
+		v.month = 1
+	}
+	if v.day == 0 {
+		v.day = 1
+	}
+	j := JalaliDate{Year: v.year, Month: v.month, Day: v.day}
+	if err := j.Validate(); err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidDate, err)
+	}
+	if v.hasWeekday && v.weekday != j.DayOfWeek() {
+		return time.Time{}, fmt.Errorf("%w: %s is a %s, not a %s", ErrInvalidDate, j, j.DayOfWeek(), v.weekday)
+	}
+
+	hour := max(v.hour, 0)
+	if v.hour12 {
+		hour %= 12
+	}
+	if v.hasPeriod && v.pm && hour < 12 {
+		hour += 12
+	}
+	if hour > 23 || v.minute > 59 || v.second > 59 {
+		return time.Time{}, fmt.Errorf("%w: invalid time %02d:%02d:%02d", ErrInvalidDate, hour, v.minute, v.second)
+	}
+
+	g := j.ToGregorian()
+	return time.Date(g.Year(), g.Month(), g.Day(), hour, v.minute, v.second, 0, loc), nil
+}
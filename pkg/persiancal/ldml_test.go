@@ -0,0 +1,118 @@
+package persiancal
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// ldmlCase is a row of testdata/ldml.golden
+type ldmlCase struct {
+	time    time.Time
+	locale  Locale
+	pattern string
+	output  string
+}
+
+// readLDMLGolden reads the golden corpus, keyed by the index of each line
+func readLDMLGolden(t *testing.T, path string) map[int]ldmlCase {
+	t.Helper()
+	_, rows := readGolden(t, path, 4)
+	cases := make(map[int]ldmlCase, len(rows))
+	for i, cols := range rows {
+		tm, err := time.Parse(time.RFC3339, cols[0])
+		if err != nil {
+			t.Fatalf("%s:%d: %v", path, i+1, err)
+		}
+		cases[i] = ldmlCase{time: tm, locale: Locale(cols[1]), pattern: cols[2], output: cols[3]}
+	}
+	return cases
+}
+
+// TestLDMLGolden compares FormatLDML with ICU's output for the same
+// pattern. The golden file is generated by testdata/ldml_icu.c, so -update
+// does not rewrite it.
+func TestLDMLGolden(t *testing.T) {
+	const path = "testdata/ldml.golden"
+	for i, c := range readLDMLGolden(t, path) {
+		got, err := FormatLDML(c.time, c.pattern, c.locale)
+		if err != nil {
+			t.Errorf("%s:%d: FormatLDML(%q) error = %v", path, i+1, c.pattern, err)
+			continue
+		}
+		if got != c.output {
+			t.Errorf("%s:%d: FormatLDML(%s, %q, %s) = %q, want %q",
+				path, i+1, c.time.Format(time.RFC3339), c.pattern, c.locale, got, c.output)
+		}
+	}
+}
+
+func TestLDMLGoldenRoundTrip(t *testing.T) {
+	const path = "testdata/ldml.golden"
+	for i, c := range readLDMLGolden(t, path) {
+		// Patterns without a year do not identify a date
+		if !strings.Contains(c.pattern, "y") {
+			continue
+		}
+		parsed, err := ParseLDML(c.pattern, c.output, c.locale, time.UTC)
+		if strings.Contains(c.pattern, "MMMMM") || strings.Contains(c.pattern, "LLLLL") {
+			if !errors.Is(err, ErrInvalidLayout) {
+				t.Errorf("%s:%d: ParseLDML(%q) error = %v, want ErrInvalidLayout", path, i+1, c.pattern, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s:%d: ParseLDML(%q, %q) error = %v", path, i+1, c.pattern, c.output, err)
+			continue
+		}
+		again, _ := FormatLDML(parsed, c.pattern, c.locale)
+		if again != c.output {
+			t.Errorf("%s:%d: %q parsed as %s, which formats as %q", path, i+1, c.output, parsed, again)
+		}
+	}
+}
+
+func TestParseLDML(t *testing.T) {
+	tehran := TehranLocation()
+	tests := []struct {
+		pattern, value string
+		locale         Locale
+		want           time.Time
+	}{
+		{"d MMMM y HH:mm", "۴ آبان ۱۴۰۴ ۱۴:۳۰", LocalePersian, time.Date(2025, 10, 26, 14, 30, 0, 0, tehran)},
+		{"d MMMM y HH:mm", "4 آبان 1404 14:30", LocalePersian, time.Date(2025, 10, 26, 14, 30, 0, 0, tehran)},
+		{"EEE, d MMM y h:mm a", "sun, 4 aban 1404 2:30 pm", LocaleEnglish, time.Date(2025, 10, 26, 14, 30, 0, 0, tehran)},
+		{"yy/M/d", "04/8/4", LocalePersian, time.Date(2025, 10, 26, 0, 0, 0, 0, tehran)},
+		{"y-D", "1403-366", LocalePersian, time.Date(2025, 3, 20, 0, 0, 0, 0, tehran)},
+	}
+	for _, tt := range tests {
+		got, err := ParseLDML(tt.pattern, tt.value, tt.locale, tehran)
+		if err != nil {
+			t.Errorf("ParseLDML(%q, %q) error = %v", tt.pattern, tt.value, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseLDML(%q, %q) = %s, want %s", tt.pattern, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseLDMLErrors(t *testing.T) {
+	tests := []struct {
+		pattern, value string
+		want           error
+	}{
+		{"y/M/d", "1404/12/30", ErrInvalidDate},
+		{"y-D", "1404-366", ErrInvalidDate},
+		{"EEEE d MMMM y", "شنبه ۴ آبان ۱۴۰۴", ErrInvalidDate},
+		{"y/M/d", "1404/8", ErrParseFailure},
+		{"y/M/d x", "1404/8/4 x", ErrInvalidLayout},
+		{"y 'quoted", "1404 quoted", ErrInvalidLayout},
+	}
+	for _, tt := range tests {
+		if _, err := ParseLDML(tt.pattern, tt.value, LocalePersian, nil); !errors.Is(err, tt.want) {
+			t.Errorf("ParseLDML(%q, %q) error = %v, want %v", tt.pattern, tt.value, err, tt.want)
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package persiancal
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 // MonthName represents a month name in different languages
 type MonthName struct {
 	Persian string
@@ -60,6 +66,33 @@ func GetMonthFromEnglishName(name string) int {
 	return 0
 }
 
+// Weekday names, indexed by time.Weekday
+var weekdayNames = []MonthName{
+	{Persian: "یکشنبه", English: "Sunday"},
+	{Persian: "دوشنبه", English: "Monday"},
+	{Persian: "سه‌شنبه", English: "Tuesday"},
+	{Persian: "چهارشنبه", English: "Wednesday"},
+	{Persian: "پنجشنبه", English: "Thursday"},
+	{Persian: "جمعه", English: "Friday"},
+	{Persian: "شنبه", English: "Saturday"},
+}
+
+// GetWeekdayNamePersian returns the Persian name of a weekday, e.g. شنبه
+func GetWeekdayNamePersian(wd time.Weekday) string {
+	if wd < time.Sunday || wd > time.Saturday {
+		return ""
+	}
+	return weekdayNames[wd].Persian
+}
+
+// GetWeekdayNameEnglish returns the English name of a weekday
+func GetWeekdayNameEnglish(wd time.Weekday) string {
+	if wd < time.Sunday || wd > time.Saturday {
+		return ""
+	}
+	return weekdayNames[wd].English
+}
+
 // equalFold is a simple case-insensitive string comparison
 func equalFold(s1, s2 string) bool {
 	if len(s1) != len(s2) {
@@ -130,3 +163,89 @@ func ToLatinDigits(s string) string {
 	}
 	return string(runes)
 }
+
+// DigitSet identifies the kind of digits used in a string
+type DigitSet int
+
+const (
+	// DigitsNone means the string has no digits
+	DigitsNone DigitSet = iota
+
+	// DigitsLatin means the string only has ASCII digits 0-9
+	DigitsLatin
+
+	// DigitsPersian means the string only has Persian digits ۰-۹
+	DigitsPersian
+
+	// DigitsArabic means the string has Arabic-Indic digits ٠-٩, which are
+	// not accepted by Parse
+	DigitsArabic
+
+	// DigitsMixed means the string mixes Latin and Persian digits
+	DigitsMixed
+)
+
+// String returns the name of the digit set
+func (d DigitSet) String() string {
+	switch d {
+	case DigitsNone:
+		return "none"
+	case DigitsLatin:
+		return "latin"
+	case DigitsPersian:
+		return "persian"
+	case DigitsArabic:
+		return "arabic"
+	case DigitsMixed:
+		return "mixed"
+	default:
+		return fmt.Sprintf("DigitSet(%d)", int(d))
+	}
+}
+
+// convert replaces the Latin and Persian digits of s with digits of the set.
+// DigitsNone and DigitsMixed leave s unchanged.
+func (d DigitSet) convert(s string) string {
+	switch d {
+	case DigitsLatin:
+		return ToLatinDigits(s)
+	case DigitsPersian:
+		return ToPersianDigits(s)
+	case DigitsArabic:
+		return strings.Map(func(r rune) rune {
+			if l, ok := latinDigits[r]; ok {
+				r = l
+			}
+			if r >= '0' && r <= '9' {
+				return '٠' + r - '0'
+			}
+			return r
+		}, s)
+	}
+	return s
+}
+
+// DetectDigits reports which digits s uses
+func DetectDigits(s string) DigitSet {
+	var latin, persian bool
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			latin = true
+		case r >= '۰' && r <= '۹':
+			persian = true
+		case r >= '٠' && r <= '٩':
+			return DigitsArabic
+		}
+	}
+	switch {
+	case latin && persian:
+		return DigitsMixed
+	case latin:
+		return DigitsLatin
+	case persian:
+		return DigitsPersian
+	default:
+		return DigitsNone
+	}
+}
@@ -0,0 +1,183 @@
+package persiancal
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MonthDay represents a day of a Jalali month that recurs every year, such
+// as a birthday or an anniversary.
+//
+// 30 Esfand is a valid MonthDay even though it only exists in leap years;
+// InYear decides what it becomes in other years.
+type MonthDay struct {
+	Month int // 1-12
+	Day   int // 1-31
+}
+
+// monthDayTokens are the layout tokens understood by MonthDay
+var monthDayTokens = []string{"MMMM", "MMM", "MM", "M", "dd", "d"}
+
+// NewMonthDay creates a new MonthDay with validation
+func NewMonthDay(month, day int) (MonthDay, error) {
+	md := MonthDay{Month: month, Day: day}
+	if err := md.Validate(); err != nil {
+		return MonthDay{}, err
+	}
+	return md, nil
+}
+
+// MonthDayOf returns the month and day of j
+func MonthDayOf(j JalaliDate) MonthDay {
+	return MonthDay{Month: j.Month, Day: j.Day}
+}
+
+// Validate checks if the MonthDay exists in at least some years
+func (md MonthDay) Validate() error {
+	if md.Month < 1 || md.Month > 12 {
+		return ErrInvalidMonth
+	}
+	if md.Day < 1 || md.Day > md.maxDays() {
+		return ErrInvalidDay
+	}
+	return nil
+}
+
+// IsValidYear reports whether the day exists in the given year. It is false
+// only for 30 Esfand in years that are not leap years.
+func (md MonthDay) IsValidYear(year int) bool {
+	return md.Validate() == nil && md.Day <= daysInJalaliMonth(year, md.Month)
+}
+
+// InYear returns the date of md in the given year. When md is 30 Esfand and
+// year is not a leap year, policy decides the result: OverflowClamp and
+// OverflowStickyEnd give 29 Esfand, OverflowRoll gives 1 Farvardin of the
+// next year and OverflowError returns an error wrapping ErrInvalidDay.
+func (md MonthDay) InYear(year int, policy OverflowPolicy) (JalaliDate, error) {
+	if err := md.Validate(); err != nil {
+		return JalaliDate{}, err
+	}
+	return resolveDay(year, md.Month, md.Day, md.Day == md.maxDays(), policy)
+}
+
+// String returns a string representation in MM/dd format
+func (md MonthDay) String() string {
+	return fmt.Sprintf("%02d/%02d", md.Month, md.Day)
+}
+
+// Format formats the MonthDay according to the given layout.
+// Supported tokens: MMMM, MMM, MM, M, dd, d
+func (md MonthDay) Format(layout string) string {
+	return formatLayout(layout, monthDayTokens, func(token string) string {
+		return formatField(token, 0, md.Month, md.Day)
+	})
+}
+
+// ParseMonthDay parses a month and day according to the given layout.
+// Supported tokens: MMMM, MMM, MM, M, dd, d
+func ParseMonthDay(layout, value string) (MonthDay, error) {
+	f, err := parseFields(layout, value, monthDayTokens, ParseOptions{})
+	if err != nil {
+		return MonthDay{}, err
+	}
+	md := MonthDay{Month: f.month, Day: f.day}
+	if err := md.Validate(); err != nil {
+		return MonthDay{}, fmt.Errorf("%w: %v", ErrInvalidDate, err)
+	}
+	return md, nil
+}
+
+// Compare returns -1 if md comes before other in the year, 0 if they are
+// equal, and +1 if md comes after other
+func (md MonthDay) Compare(other MonthDay) int {
+	if md.Month != other.Month {
+		return compareInts(md.Month, other.Month)
+	}
+	return compareInts(md.Day, other.Day)
+}
+
+// Before returns true if md comes before other in the year
+func (md MonthDay) Before(other MonthDay) bool {
+	return md.Compare(other) < 0
+}
+
+// After returns true if md comes after other in the year
+func (md MonthDay) After(other MonthDay) bool {
+	return md.Compare(other) > 0
+}
+
+// Equal returns true if md equals other
+func (md MonthDay) Equal(other MonthDay) bool {
+	return md == other
+}
+
+// AddMonths adds n months, wrapping around the year, and returns a new
+// MonthDay. The day is clamped to the longest the target month can be, so
+// 31 Shahrivar + 1 month is 30 Mehr and 30 Dey + 2 months is 30 Esfand.
+func (md MonthDay) AddMonths(n int) MonthDay {
+	month := floorMod(md.Month-1+n, 12) + 1
+	result := MonthDay{Month: month, Day: md.Day}
+	if maxDay := result.maxDays(); result.Day > maxDay {
+		result.Day = maxDay
+	}
+	return result
+}
+
+// Range returns the single-day range of md in the given year, with 30 Esfand
+// resolved according to policy as in InYear
+func (md MonthDay) Range(year int, policy OverflowPolicy) (Range, error) {
+	j, err := md.InYear(year, policy)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{Start: j, End: j}, nil
+}
+
+// IsZero reports whether md is the zero MonthDay
+func (md MonthDay) IsZero() bool {
+	return md == MonthDay{}
+}
+
+// MarshalJSON implements json.Marshaler. The day is encoded as "MM-dd", and
+// the zero MonthDay as null.
+func (md MonthDay) MarshalJSON() ([]byte, error) {
+	if md.IsZero() {
+		return []byte("null"), nil
+	}
+	if err := md.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(md.Format("MM-dd"))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts "MM-dd" and "MM/dd"
+// with Persian or Latin digits, and null for the zero MonthDay.
+func (md *MonthDay) UnmarshalJSON(data []byte) error {
+	s, ok, err := unquoteJSON(data)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		*md = MonthDay{}
+		return nil
+	}
+	v, err := ParseMonthDay("MM-dd", s)
+	if err != nil {
+		alt, altErr := ParseMonthDay("MM/dd", s)
+		if altErr != nil {
+			return err
+		}
+		v = alt
+	}
+	*md = v
+	return nil
+}
+
+// maxDays returns the longest the month can be in any year: 31 for the
+// first six months and 30 for the rest, counting Esfand in leap years
+func (md MonthDay) maxDays() int {
+	if md.Month <= 6 {
+		return 31
+	}
+	return 30
+}
@@ -0,0 +1,111 @@
+package persiancal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMonthDayFormatParse(t *testing.T) {
+	md := MonthDay{8, 4}
+	tests := []struct {
+		layout, want string
+	}{
+		{"MM/dd", "08/04"},
+		{"M-d", "8-4"},
+		{"d MMMM", "4 آبان"},
+		{"MMM d", "Aban 4"},
+	}
+	for _, tt := range tests {
+		if got := md.Format(tt.layout); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+		if got, err := ParseMonthDay(tt.layout, tt.want); err != nil || got != md {
+			t.Errorf("ParseMonthDay(%q, %q) = %v, %v; want %v", tt.layout, tt.want, got, err, md)
+		}
+	}
+
+	// 30 Esfand exists in leap years, 31 Mehr never does
+	if got, err := ParseMonthDay("MM/dd", "12/30"); err != nil || got != (MonthDay{12, 30}) {
+		t.Errorf("ParseMonthDay(12/30) = %v, %v", got, err)
+	}
+	if _, err := ParseMonthDay("MM/dd", "07/31"); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("ParseMonthDay(07/31) error = %v, want ErrInvalidDate", err)
+	}
+}
+
+func TestMonthDayInYear(t *testing.T) {
+	esfand30 := MonthDay{12, 30}
+	tests := []struct {
+		year   int
+		policy OverflowPolicy
+		want   JalaliDate
+		err    error
+	}{
+		{1403, OverflowError, JalaliDate{1403, 12, 30}, nil},
+		{1404, OverflowClamp, JalaliDate{1404, 12, 29}, nil},
+		{1404, OverflowStickyEnd, JalaliDate{1404, 12, 29}, nil},
+		{1404, OverflowRoll, JalaliDate{1405, 1, 1}, nil},
+		{1404, OverflowError, JalaliDate{}, ErrInvalidDay},
+	}
+	for _, tt := range tests {
+		got, err := esfand30.InYear(tt.year, tt.policy)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("InYear(%d, %s) = %s, %v; want %s, %v", tt.year, tt.policy, got, err, tt.want, tt.err)
+		}
+	}
+	if esfand30.IsValidYear(1404) || !esfand30.IsValidYear(1403) {
+		t.Errorf("IsValidYear(1404, 1403) = %v, %v; want false, true",
+			esfand30.IsValidYear(1404), esfand30.IsValidYear(1403))
+	}
+}
+
+func TestMonthDayAddMonths(t *testing.T) {
+	tests := []struct {
+		md   MonthDay
+		n    int
+		want MonthDay
+	}{
+		{MonthDay{6, 31}, 1, MonthDay{7, 30}},
+		{MonthDay{10, 30}, 2, MonthDay{12, 30}},
+		{MonthDay{12, 15}, 1, MonthDay{1, 15}},
+		{MonthDay{1, 31}, -1, MonthDay{12, 30}},
+		{MonthDay{8, 4}, 24, MonthDay{8, 4}},
+	}
+	for _, tt := range tests {
+		if got := tt.md.AddMonths(tt.n); got != tt.want {
+			t.Errorf("%v.AddMonths(%d) = %v, want %v", tt.md, tt.n, got, tt.want)
+		}
+	}
+	if !(MonthDay{1, 31}).Before(MonthDay{2, 1}) || (MonthDay{12, 1}).Compare(MonthDay{11, 30}) != 1 {
+		t.Error("MonthDay comparisons are wrong")
+	}
+}
+
+func TestMonthDayJSON(t *testing.T) {
+	type row struct {
+		Birthday MonthDay
+		Unset    MonthDay
+	}
+	in := row{Birthday: MonthDay{12, 30}}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Birthday":"12-30","Unset":null}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+
+	out := row{Unset: MonthDay{1, 1}}
+	if err := json.Unmarshal(data, &out); err != nil || out != in {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", data, out, err, in)
+	}
+
+	var md MonthDay
+	if err := json.Unmarshal([]byte(`"۰۸/۰۴"`), &md); err != nil || md != (MonthDay{8, 4}) {
+		t.Errorf("Unmarshal(MM/dd with Persian digits) = %v, %v", md, err)
+	}
+	if _, err := json.Marshal(MonthDay{7, 31}); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("Marshal(07/31) error = %v, want ErrInvalidDay", err)
+	}
+}
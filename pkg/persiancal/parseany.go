@@ -0,0 +1,195 @@
+package persiancal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultParseLayouts are the layouts tried by ParseAny when no layouts are
+// given: year-first and day-first numeric dates with -, / and . separators,
+// and day-first dates with Persian or English month names of the Jalali or
+// Gregorian calendar
+var DefaultParseLayouts = []string{
+	"yyyy-M-d", "yyyy/M/d", "yyyy.M.d",
+	"d-M-yyyy", "d/M/yyyy", "d.M.yyyy",
+	"d MMMM yyyy", "d MMM yyyy",
+}
+
+// YearSpan is an inclusive range of years
+type YearSpan struct {
+	From, To int
+}
+
+// Contains reports whether year is in the span
+func (s YearSpan) Contains(year int) bool {
+	return year >= s.From && year <= s.To
+}
+
+// Default year spans used by ParseAny to tell Jalali from Gregorian dates
+var (
+	DefaultJalaliYears    = YearSpan{From: 1200, To: 1499}
+	DefaultGregorianYears = YearSpan{From: 1800, To: 2199}
+)
+
+// ParseAnyOptions configures ParseAny. The zero value tries
+// DefaultParseLayouts and detects the calendar from the year.
+type ParseAnyOptions struct {
+	// Layouts are tried in order; DefaultParseLayouts if empty
+	Layouts []string
+
+	// Calendar is the calendar the value is written in. If nil, the value
+	// is taken as Jalali when its year is in JalaliYears and as Gregorian
+	// when it is in GregorianYears.
+	Calendar Calendar
+
+	// JalaliYears and GregorianYears are used to detect the calendar;
+	// DefaultJalaliYears and DefaultGregorianYears if zero
+	JalaliYears    YearSpan
+	GregorianYears YearSpan
+
+	// ParseOptions control two-digit years and lenient parsing
+	ParseOptions
+}
+
+// ParseResult is a date read by ParseAny
+type ParseResult struct {
+	Date     JalaliDate // the date, converted to Jalali if necessary
+	Calendar Calendar   // calendar the value was written in
+	Layout   string     // layout that matched
+}
+
+// Time returns the date at midnight UTC
+func (r ParseResult) Time() time.Time {
+	return r.Date.ToGregorian()
+}
+
+// ParseAny parses a date written in an unknown layout, and in the Jalali or
+// Gregorian calendar. opts may be nil.
+//
+// Every layout is tried. If the layouts that match give different dates, or
+// the calendar cannot be told from the year, the error wraps
+// ErrAmbiguousDate. If no layout matches, the error is the *ParseError of
+// the layout that matched the most characters.
+//
+//	r, err := persiancal.ParseAny("26/10/2025", nil)
+//	// r.Date = 1404/08/04, r.Calendar = GregorianCalendar, r.Layout = "d/M/yyyy"
+func ParseAny(value string, opts *ParseAnyOptions) (ParseResult, error) {
+	var o ParseAnyOptions
+	if opts != nil {
+		o = *opts
+	}
+	if len(o.Layouts) == 0 {
+		o.Layouts = DefaultParseLayouts
+	}
+	if o.JalaliYears == (YearSpan{}) {
+		o.JalaliYears = DefaultJalaliYears
+	}
+	if o.GregorianYears == (YearSpan{}) {
+		o.GregorianYears = DefaultGregorianYears
+	}
+
+	var (
+		results  []ParseResult
+		parseErr *ParseError // furthest parse error
+		dateErr  error       // first error from a layout that matched
+	)
+	for _, layout := range o.Layouts {
+		r, err := o.parse(layout, value)
+		var pe *ParseError
+		switch {
+		case errors.As(err, &pe):
+			if parseErr == nil || pe.Offset > parseErr.Offset {
+				parseErr = pe
+			}
+		case err != nil:
+			if dateErr == nil {
+				dateErr = err
+			}
+		case !containsResult(results, r):
+			results = append(results, r)
+		}
+	}
+
+	switch {
+	case len(results) == 1:
+		return results[0], nil
+	case len(results) > 1:
+		candidates := make([]string, len(results))
+		for i, r := range results {
+			candidates[i] = fmt.Sprintf("%s (%s, %s)", r.Date, r.Calendar.Name(), r.Layout)
+		}
+		return ParseResult{}, fmt.Errorf("%w: %q could be %s", ErrAmbiguousDate, value, strings.Join(candidates, " or "))
+	case dateErr != nil:
+		return ParseResult{}, dateErr
+	case parseErr != nil:
+		return ParseResult{}, parseErr
+	default:
+		return ParseResult{}, fmt.Errorf("%w: no layouts to try", ErrInvalidLayout)
+	}
+}
+
+// parse parses value with a single layout and resolves its calendar
+func (o ParseAnyOptions) parse(layout, value string) (ParseResult, error) {
+	// Month names are read in the calendar of the value, or as Jalali and
+	// then Gregorian names if it is unknown
+	names := o.Calendar
+	if names == nil {
+		names = SolarHijriCalendar
+	}
+	f, err := parseFieldsIn(layout, value, dateTokens, names, o.ParseOptions)
+	if err != nil && o.Calendar == nil && hasMonthNames(layout) {
+		if g, gerr := parseFieldsIn(layout, value, dateTokens, GregorianCalendar, o.ParseOptions); gerr == nil {
+			f, err, names = g, nil, GregorianCalendar
+		}
+	}
+	if err != nil {
+		return ParseResult{}, err
+	}
+
+	cal := o.Calendar
+	if cal == nil {
+		switch {
+		case o.JalaliYears.Contains(f.year):
+			cal = SolarHijriCalendar
+		case o.GregorianYears.Contains(f.year):
+			cal = GregorianCalendar
+		default:
+			return ParseResult{}, fmt.Errorf("%w: year %d is neither in the Jalali range %d-%d nor in the Gregorian range %d-%d",
+				ErrAmbiguousDate, f.year, o.JalaliYears.From, o.JalaliYears.To, o.GregorianYears.From, o.GregorianYears.To)
+		}
+	}
+	if cal != names && hasMonthNames(layout) {
+		return ParseResult{}, fmt.Errorf("%w: %s month name in a %s date", ErrInvalidDate, names.Name(), cal.Name())
+	}
+
+	n, err := cal.ToDayNumber(f.year, f.month, f.day)
+	if err != nil {
+		return ParseResult{}, fmt.Errorf("%w: %v", ErrInvalidDate, err)
+	}
+	j, err := n.Jalali()
+	if err != nil {
+		return ParseResult{}, err
+	}
+	if f.hasWeekday && f.weekday != n.Weekday() {
+		return ParseResult{}, fmt.Errorf("%w: %s is a %s, not a %s", ErrInvalidDate, j, n.Weekday(), f.weekday)
+	}
+	return ParseResult{Date: j, Calendar: cal, Layout: layout}, nil
+}
+
+// hasMonthNames reports whether layout has a month name token
+func hasMonthNames(layout string) bool {
+	return strings.Contains(layout, "MMM")
+}
+
+// containsResult reports whether results has a result with the same date
+// and calendar as r
+func containsResult(results []ParseResult, r ParseResult) bool {
+	for _, other := range results {
+		if other.Date == r.Date && other.Calendar == r.Calendar {
+			return true
+		}
+	}
+	return false
+}
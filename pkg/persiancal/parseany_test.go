@@ -0,0 +1,73 @@
+package persiancal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAny(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   JalaliDate
+		cal    Calendar
+		layout string
+	}{
+		{"26/10/2025", JalaliDate{1404, 8, 4}, GregorianCalendar, "d/M/yyyy"},
+		{"2025-10-26", JalaliDate{1404, 8, 4}, GregorianCalendar, "yyyy-M-d"},
+		{"1404/08/04", JalaliDate{1404, 8, 4}, SolarHijriCalendar, "yyyy/M/d"},
+		{"۱۴۰۴/۸/۴", JalaliDate{1404, 8, 4}, SolarHijriCalendar, "yyyy/M/d"},
+		{"4.8.1404", JalaliDate{1404, 8, 4}, SolarHijriCalendar, "d.M.yyyy"},
+		{"4 آبان 1404", JalaliDate{1404, 8, 4}, SolarHijriCalendar, "d MMMM yyyy"},
+		{"26 October 2025", JalaliDate{1404, 8, 4}, GregorianCalendar, "d MMM yyyy"},
+		{"20/3/2025", JalaliDate{1403, 12, 30}, GregorianCalendar, "d/M/yyyy"},
+		{"21/3/2025", JalaliDate{1404, 1, 1}, GregorianCalendar, "d/M/yyyy"},
+	}
+	for _, tt := range tests {
+		r, err := ParseAny(tt.value, nil)
+		if err != nil {
+			t.Errorf("ParseAny(%q) error = %v", tt.value, err)
+			continue
+		}
+		if r.Date != tt.want || r.Calendar != tt.cal || r.Layout != tt.layout {
+			t.Errorf("ParseAny(%q) = %s, %s, %q; want %s, %s, %q",
+				tt.value, r.Date, r.Calendar.Name(), r.Layout, tt.want, tt.cal.Name(), tt.layout)
+		}
+	}
+}
+
+func TestParseAnyErrors(t *testing.T) {
+	tests := []struct {
+		value string
+		opts  *ParseAnyOptions
+		want  error
+	}{
+		{"1/2/1700", nil, ErrAmbiguousDate},
+		{"1/2/3", nil, ErrParseFailure},
+		{"30/2/2025", nil, ErrInvalidDate},
+		{"1404/12/30", nil, ErrInvalidDate},
+		{"tomorrow", nil, ErrParseFailure},
+		{"4 آبان 2025", nil, ErrInvalidDate},
+		{"4/8/1404", &ParseAnyOptions{Layouts: []string{"d/M/yyyy", "M/d/yyyy"}}, ErrAmbiguousDate},
+	}
+	for _, tt := range tests {
+		if _, err := ParseAny(tt.value, tt.opts); !errors.Is(err, tt.want) {
+			t.Errorf("ParseAny(%q) error = %v, want %v", tt.value, err, tt.want)
+		}
+	}
+}
+
+func TestParseAnyCalendar(t *testing.T) {
+	// A forced calendar overrides the year ranges
+	r, err := ParseAny("1404/08/04", &ParseAnyOptions{Calendar: GregorianCalendar})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Calendar != GregorianCalendar || r.Time().Format("2006-01-02") != "1404-08-04" {
+		t.Errorf("ParseAny(1404/08/04, Gregorian) = %s (%s)", r.Date, r.Time().Format("2006-01-02"))
+	}
+
+	r, err = ParseAny("10/1/1300", &ParseAnyOptions{JalaliYears: YearSpan{From: 1300, To: 1310}})
+	if err != nil || r.Date != (JalaliDate{1300, 1, 10}) {
+		t.Errorf("ParseAny(10/1/1300) = %s, %v; want 1300/01/10", r.Date, err)
+	}
+}
@@ -0,0 +1,66 @@
+package persiancal
+
+import "fmt"
+
+// Period is an amount of calendar time in years, months and days, such as
+// the age of a person. Unlike a day count, adding a Period to a date follows
+// the lengths of the Jalali months it crosses.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// PeriodBetween returns the period from one date to another, counting whole
+// years, then whole months, then the remaining days. If to is before from,
+// every field of the result is negative or zero.
+//
+//	p := persiancal.PeriodBetween(birth, persiancal.Today())
+//	fmt.Printf("%d years, %d months and %d days\n", p.Years, p.Months, p.Days)
+func PeriodBetween(from, to JalaliDate) Period {
+	if to.Before(from) {
+		return PeriodBetween(to, from).Negate()
+	}
+
+	months := (to.Year-from.Year)*12 + (to.Month - from.Month)
+	if to.Day < from.Day {
+		months--
+	}
+	days := to.DaysBetween(from.AddMonths(months))
+
+	return Period{Years: months / 12, Months: months % 12, Days: days}
+}
+
+// IsZero returns true if all fields of p are zero
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns p with every field negated
+func (p Period) Negate() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+}
+
+// AddTo returns j advanced by p: years and months first, clamping the day to
+// the end of the month, then days
+func (p Period) AddTo(j JalaliDate) JalaliDate {
+	return j.AddMonths(p.Years*12 + p.Months).AddDays(p.Days)
+}
+
+// String returns the period in ISO 8601 form, e.g. P33Y2M5D
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+	s := "P"
+	if p.Years != 0 {
+		s += fmt.Sprintf("%dY", p.Years)
+	}
+	if p.Months != 0 {
+		s += fmt.Sprintf("%dM", p.Months)
+	}
+	if p.Days != 0 {
+		s += fmt.Sprintf("%dD", p.Days)
+	}
+	return s
+}
@@ -0,0 +1,94 @@
+package persiancal
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Printable is a JalaliDate that implements fmt.Formatter. JalaliDate
+// itself cannot: fmt.Formatter needs a method Format(fmt.State, rune), and
+// JalaliDate already has Format(layout string).
+//
+// Printable and Day understand these verbs:
+//   - %v: yyyy/MM/dd, as String
+//   - %+v: long Persian form, e.g. یکشنبه 4 آبان 1404
+//   - %#v: Go syntax, e.g. persiancal.JalaliDate{Year:1404, Month:8, Day:4}
+//   - %s: ISO 8601, e.g. 1404-08-04; %q: the same, quoted
+//
+// The space flag (e.g. "% v") selects Persian digits. A width pads the
+// output to that many columns of display width, on the left or, with the
+// - flag, on the right.
+//
+//	fmt.Printf("%+v", j.Printable()) // یکشنبه 4 آبان 1404
+type Printable JalaliDate
+
+// Printable returns j as a Printable
+func (j JalaliDate) Printable() Printable {
+	return Printable(j)
+}
+
+// Format implements fmt.Formatter
+func (p Printable) Format(f fmt.State, verb rune) {
+	j := JalaliDate(p)
+	formatVerb(f, verb, j, fmt.Sprintf("persiancal.JalaliDate{Year:%d, Month:%d, Day:%d}", j.Year, j.Month, j.Day))
+}
+
+// Format implements fmt.Formatter with the verbs of Printable. The integer
+// verbs %b, %c, %d, %o, %O, %x, %X and %U print the day count as an int32.
+func (d Day) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'b', 'c', 'd', 'o', 'O', 'x', 'X', 'U':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int32(d))
+		return
+	}
+	formatVerb(f, verb, d.JalaliDate(), fmt.Sprintf("persiancal.Day(%d)", int32(d)))
+}
+
+// formatVerb writes j for a fmt verb. goSyntax is the output of %#v.
+func formatVerb(f fmt.State, verb rune, j JalaliDate, goSyntax string) {
+	var s string
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		s = goSyntax
+	case verb == 'v' && f.Flag('+'):
+		s = j.Format(LayoutFull)
+	case verb == 'v':
+		s = j.String()
+	case verb == 's':
+		s = j.Format(LayoutISO)
+	case verb == 'q':
+		s = `"` + j.Format(LayoutISO) + `"`
+	default:
+		fmt.Fprintf(f, "%%!%c(%s)", verb, goSyntax)
+		return
+	}
+	if f.Flag(' ') && !f.Flag('#') {
+		s = ToPersianDigits(s)
+	}
+
+	if width, ok := f.Width(); ok {
+		if pad := width - DisplayWidth(s); pad > 0 {
+			if f.Flag('-') {
+				s += strings.Repeat(" ", pad)
+			} else {
+				s = strings.Repeat(" ", pad) + s
+			}
+		}
+	}
+	fmt.Fprint(f, s)
+}
+
+// DisplayWidth returns the number of columns s takes on a terminal.
+// Combining marks and format characters such as the zero-width non-joiner
+// and bidi marks take no space; every other character takes one column.
+func DisplayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		if r == utf8.RuneError || !unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+			width++
+		}
+	}
+	return width
+}
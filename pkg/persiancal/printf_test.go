@@ -0,0 +1,77 @@
+package persiancal
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPrintable(t *testing.T) {
+	p := JalaliDate{1404, 8, 4}.Printable()
+	tests := []struct {
+		format, want string
+	}{
+		{"%v", "1404/08/04"},
+		{"% v", "۱۴۰۴/۰۸/۰۴"},
+		{"%+v", "یکشنبه 4 آبان 1404"},
+		{"% +v", "یکشنبه ۴ آبان ۱۴۰۴"},
+		{"%#v", "persiancal.JalaliDate{Year:1404, Month:8, Day:4}"},
+		{"% #v", "persiancal.JalaliDate{Year:1404, Month:8, Day:4}"},
+		{"%s", "1404-08-04"},
+		{"%q", `"1404-08-04"`},
+		{"[%12v]", "[  1404/08/04]"},
+		{"[%-12s]", "[1404-08-04  ]"},
+		{"[%+-20v]", "[یکشنبه 4 آبان 1404  ]"},
+		{"[%+20v]", "[  یکشنبه 4 آبان 1404]"},
+		{"%d", "%!d(persiancal.JalaliDate{Year:1404, Month:8, Day:4})"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, p); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestDayFormat(t *testing.T) {
+	d, err := NewDay(1404, 8, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := int32(d)
+	tests := []struct {
+		format, want string
+	}{
+		{"%v", "1404/08/04"},
+		{"% s", "۱۴۰۴-۰۸-۰۴"},
+		{"%#v", fmt.Sprintf("persiancal.Day(%d)", n)},
+		{"%d", fmt.Sprint(n)},
+		{"%08d", fmt.Sprintf("%08d", n)},
+		{"%x", fmt.Sprintf("%x", n)},
+		{"%#X", fmt.Sprintf("%#X", n)},
+		{"%o", fmt.Sprintf("%o", n)},
+		{"%O", fmt.Sprintf("%O", n)},
+		{"%b", fmt.Sprintf("%b", n)},
+		{"%U", fmt.Sprintf("%U", n)},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, d); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"1404/08/04", 10},
+		{"یکشنبه", 6},
+		{"می‌شود", 5}, // the zero-width non-joiner takes no space
+		{"⁨۴ آبان⁩", 6},
+	}
+	for _, tt := range tests {
+		if got := DisplayWidth(tt.s); got != tt.want {
+			t.Errorf("DisplayWidth(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
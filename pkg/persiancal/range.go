@@ -0,0 +1,140 @@
+package persiancal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Range represents an inclusive span of Jalali dates, from Start through End
+type Range struct {
+	Start JalaliDate
+	End   JalaliDate
+}
+
+// NewRange creates a new Range with validation
+func NewRange(start, end JalaliDate) (Range, error) {
+	r := Range{Start: start, End: end}
+	if err := r.Validate(); err != nil {
+		return Range{}, err
+	}
+	return r, nil
+}
+
+// YearRange returns the range covering a whole Jalali year
+func YearRange(year int) Range {
+	return Range{
+		Start: JalaliDate{Year: year, Month: 1, Day: 1},
+		End:   JalaliDate{Year: year, Month: 12, Day: daysInJalaliMonth(year, 12)},
+	}
+}
+
+// MonthRange returns the range covering a Jalali month
+func MonthRange(year, month int) (Range, error) {
+	if month < 1 || month > 12 {
+		return Range{}, ErrInvalidMonth
+	}
+	return Range{
+		Start: JalaliDate{Year: year, Month: month, Day: 1},
+		End:   JalaliDate{Year: year, Month: month, Day: daysInJalaliMonth(year, month)},
+	}, nil
+}
+
+// QuarterRange returns the range covering a Jalali quarter (1-4).
+// Quarters follow the Iranian fiscal layout: Q1 is Farvardin to Khordad,
+// Q2 Tir to Shahrivar, Q3 Mehr to Azar and Q4 Dey to Esfand.
+func QuarterRange(year, quarter int) (Range, error) {
+	if quarter < 1 || quarter > 4 {
+		return Range{}, ErrInvalidQuarter
+	}
+	first := (quarter-1)*3 + 1
+	last := first + 2
+	return Range{
+		Start: JalaliDate{Year: year, Month: first, Day: 1},
+		End:   JalaliDate{Year: year, Month: last, Day: daysInJalaliMonth(year, last)},
+	}, nil
+}
+
+// WeekRange returns the Saturday-to-Friday week containing j
+func WeekRange(j JalaliDate) Range {
+	return UnitRange(j, Week)
+}
+
+// Validate checks that both ends are valid dates and Start is not after End
+func (r Range) Validate() error {
+	if err := r.Start.Validate(); err != nil {
+		return err
+	}
+	if err := r.End.Validate(); err != nil {
+		return err
+	}
+	if r.Start.After(r.End) {
+		return fmt.Errorf("%w: range start %s is after end %s", ErrInvalidDate, r.Start, r.End)
+	}
+	return nil
+}
+
+// Contains reports whether j falls within the range
+func (r Range) Contains(j JalaliDate) bool {
+	return !j.Before(r.Start) && !j.After(r.End)
+}
+
+// Days returns the number of days in the range, counting both ends
+func (r Range) Days() int {
+	return r.End.DaysBetween(r.Start) + 1
+}
+
+// Bounds returns the half-open Gregorian interval [start, end) covering the
+// range in the given location: start is midnight at the beginning of Start
+// and end is midnight at the beginning of the day after End. A nil location
+// is treated as UTC.
+func (r Range) Bounds(loc *time.Location) (start, end time.Time, err error) {
+	if err := r.Validate(); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return midnightIn(r.Start, loc), midnightIn(r.End.AddDays(1), loc), nil
+}
+
+// String returns a string representation of the range in yyyy/MM/dd - yyyy/MM/dd format
+func (r Range) String() string {
+	return r.Start.String() + " - " + r.End.String()
+}
+
+// YearBounds returns the half-open Gregorian bounds of a Jalali year
+func YearBounds(year int, loc *time.Location) (start, end time.Time, err error) {
+	return YearRange(year).Bounds(loc)
+}
+
+// MonthBounds returns the half-open Gregorian bounds of a Jalali month
+func MonthBounds(year, month int, loc *time.Location) (start, end time.Time, err error) {
+	r, err := MonthRange(year, month)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return r.Bounds(loc)
+}
+
+// QuarterBounds returns the half-open Gregorian bounds of a Jalali quarter
+func QuarterBounds(year, quarter int, loc *time.Location) (start, end time.Time, err error) {
+	r, err := QuarterRange(year, quarter)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return r.Bounds(loc)
+}
+
+// WeekBounds returns the half-open Gregorian bounds of the Saturday-to-Friday
+// week containing j
+func WeekBounds(j JalaliDate, loc *time.Location) (start, end time.Time, err error) {
+	return WeekRange(j).Bounds(loc)
+}
+
+// midnightIn returns the start of the Jalali day j in loc
+func midnightIn(j JalaliDate, loc *time.Location) time.Time {
+	return DayNumber(jalaliToJDN(j.Year, j.Month, j.Day)).Time(loc)
+}
+
+// daysSinceSaturday returns how many days have passed since the most recent
+// Saturday, the first day of the Persian week (0 for Saturday, 6 for Friday)
+func daysSinceSaturday(wd time.Weekday) int {
+	return (int(wd) + 1) % 7
+}
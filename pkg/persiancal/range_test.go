@@ -0,0 +1,91 @@
+package persiancal
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRanges(t *testing.T) {
+	month := func(y, m int) Range { r, _ := MonthRange(y, m); return r }
+	quarter := func(y, q int) Range { r, _ := QuarterRange(y, q); return r }
+
+	tests := []struct {
+		name       string
+		r          Range
+		start, end string // half-open UTC bounds
+		days       int
+	}{
+		{"year 1403", YearRange(1403), "2024-03-20", "2025-03-21", 366},
+		{"year 1404", YearRange(1404), "2025-03-21", "2026-03-21", 365},
+		{"month 1404/08", month(1404, 8), "2025-10-23", "2025-11-22", 30},
+		{"month 1403/12", month(1403, 12), "2025-02-19", "2025-03-21", 30},
+		{"month 1404/12", month(1404, 12), "2026-02-20", "2026-03-21", 29},
+		{"quarter 1404/1", quarter(1404, 1), "2025-03-21", "2025-06-22", 93},
+		{"quarter 1404/4", quarter(1404, 4), "2025-12-22", "2026-03-21", 89},
+		{"week of 1404/08/04", WeekRange(JalaliDate{1404, 8, 4}), "2025-10-25", "2025-11-01", 7},
+		{"week across Nowruz", WeekRange(JalaliDate{1404, 1, 1}), "2025-03-15", "2025-03-22", 7},
+	}
+	for _, tt := range tests {
+		start, end, err := tt.r.Bounds(nil)
+		if err != nil {
+			t.Errorf("%s: Bounds error = %v", tt.name, err)
+			continue
+		}
+		if got := start.Format(time.DateOnly); got != tt.start {
+			t.Errorf("%s: start = %s, want %s", tt.name, got, tt.start)
+		}
+		if got := end.Format(time.DateOnly); got != tt.end {
+			t.Errorf("%s: end = %s, want %s", tt.name, got, tt.end)
+		}
+		if got := tt.r.Days(); got != tt.days {
+			t.Errorf("%s: Days() = %d, want %d", tt.name, got, tt.days)
+		}
+	}
+}
+
+func TestRangeBoundsInLocation(t *testing.T) {
+	start, end, err := MonthBounds(1404, 8, TehranLocation())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if start.Location() != TehranLocation() || start.Hour() != 0 || end.Hour() != 0 {
+		t.Errorf("MonthBounds in Tehran = %v, %v; want local midnights", start, end)
+	}
+	if got := start.UTC().Format(time.RFC3339); got != "2025-10-22T20:30:00Z" {
+		t.Errorf("start = %s, want 2025-10-22T20:30:00Z", got)
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	r := YearRange(1403)
+	tests := []struct {
+		j    JalaliDate
+		want bool
+	}{
+		{JalaliDate{1402, 12, 29}, false},
+		{JalaliDate{1403, 1, 1}, true},
+		{JalaliDate{1403, 12, 30}, true},
+		{JalaliDate{1404, 1, 1}, false},
+	}
+	for _, tt := range tests {
+		if got := r.Contains(tt.j); got != tt.want {
+			t.Errorf("%s.Contains(%s) = %v, want %v", r, tt.j, got, tt.want)
+		}
+	}
+}
+
+func TestRangeErrors(t *testing.T) {
+	if _, err := MonthRange(1404, 13); !errors.Is(err, ErrInvalidMonth) {
+		t.Errorf("MonthRange(1404, 13) error = %v, want ErrInvalidMonth", err)
+	}
+	if _, err := QuarterRange(1404, 0); !errors.Is(err, ErrInvalidQuarter) {
+		t.Errorf("QuarterRange(1404, 0) error = %v, want ErrInvalidQuarter", err)
+	}
+	if _, err := NewRange(JalaliDate{1404, 2, 1}, JalaliDate{1404, 1, 1}); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("NewRange(reversed) error = %v, want ErrInvalidDate", err)
+	}
+	if _, _, err := YearBounds(10000, nil); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("YearBounds(10000) error = %v, want ErrOutOfRange", err)
+	}
+}
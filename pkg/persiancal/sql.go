@@ -0,0 +1,283 @@
+package persiancal
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// sqlTimeLayouts are the textual forms drivers commonly use for DATE,
+// DATETIME and TIMESTAMP columns when they hand back strings or bytes
+var sqlTimeLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	time.RFC3339Nano,
+}
+
+// jalaliTextLayouts are the layouts accepted when reading Jalali dates
+// stored as text
+var jalaliTextLayouts = []string{
+	LayoutSlash,
+	LayoutISO,
+	LayoutDot,
+}
+
+// Scan implements sql.Scanner. The source is expected to hold a Gregorian
+// date (time.Time, or its string or []byte form) and is converted with
+// FromGregorianDate.
+func (j *JalaliDate) Scan(src any) error {
+	t, err := scanGregorian(src)
+	if err != nil {
+		return err
+	}
+	*j = FromGregorianDate(t)
+	return nil
+}
+
+// Value implements driver.Valuer. The date is written as its Gregorian
+// equivalent at midnight UTC.
+func (j JalaliDate) Value() (driver.Value, error) {
+	if err := j.Validate(); err != nil {
+		return nil, err
+	}
+	return j.ToGregorian(), nil
+}
+
+// NullJalaliDate represents a JalaliDate that may be null.
+// It works like sql.NullTime.
+type NullJalaliDate struct {
+	JalaliDate JalaliDate
+	Valid      bool // Valid is true if JalaliDate is not NULL
+}
+
+// Scan implements sql.Scanner
+func (n *NullJalaliDate) Scan(src any) error {
+	if src == nil {
+		n.JalaliDate, n.Valid = JalaliDate{}, false
+		return nil
+	}
+	if err := n.JalaliDate.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer
+func (n NullJalaliDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.JalaliDate.Value()
+}
+
+// JalaliText is a JalaliDate stored in a text column in its Jalali form
+// (yyyy/MM/dd) instead of as a Gregorian DATE. It is meant for legacy
+// schemas that keep values such as "1404/08/04".
+type JalaliText struct {
+	JalaliDate
+}
+
+// Scan implements sql.Scanner. Values written as yyyy/MM/dd, yyyy-MM-dd
+// or yyyy.MM.dd are accepted, with Persian or Latin digits.
+func (j *JalaliText) Scan(src any) error {
+	d, err := scanJalaliText(src)
+	if err != nil {
+		return err
+	}
+	j.JalaliDate = d
+	return nil
+}
+
+// Value implements driver.Valuer. The date is written as a yyyy/MM/dd string.
+func (j JalaliText) Value() (driver.Value, error) {
+	if err := j.Validate(); err != nil {
+		return nil, err
+	}
+	return j.Format(LayoutSlash), nil
+}
+
+// NullJalaliText represents a JalaliText that may be null
+type NullJalaliText struct {
+	JalaliText JalaliText
+	Valid      bool // Valid is true if JalaliText is not NULL
+}
+
+// Scan implements sql.Scanner
+func (n *NullJalaliText) Scan(src any) error {
+	if src == nil {
+		n.JalaliText, n.Valid = JalaliText{}, false
+		return nil
+	}
+	if err := n.JalaliText.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer
+func (n NullJalaliText) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.JalaliText.Value()
+}
+
+// scanGregorian extracts a Gregorian time.Time from a database value
+func scanGregorian(src any) (time.Time, error) {
+	switch v := src.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		return parseSQLTime(v)
+	case []byte:
+		return parseSQLTime(string(v))
+	case nil:
+		return time.Time{}, fmt.Errorf("%w: cannot scan NULL into JalaliDate", ErrInvalidDate)
+	default:
+		return time.Time{}, fmt.Errorf("%w: cannot scan %T into JalaliDate", ErrInvalidDate, src)
+	}
+}
+
+// parseSQLTime parses a Gregorian date or timestamp in one of sqlTimeLayouts
+func parseSQLTime(s string) (time.Time, error) {
+	for _, layout := range sqlTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: unsupported Gregorian date value %q", ErrParseFailure, s)
+}
+
+// scanJalaliText extracts a JalaliDate from a text database value
+func scanJalaliText(src any) (JalaliDate, error) {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		return JalaliDate{}, fmt.Errorf("%w: cannot scan NULL into JalaliText", ErrInvalidDate)
+	default:
+		return JalaliDate{}, fmt.Errorf("%w: cannot scan %T into JalaliText", ErrInvalidDate, src)
+	}
+
+	var err error
+	for _, layout := range jalaliTextLayouts {
+		var j JalaliDate
+		if j, err = Parse(layout, s); err == nil {
+			return j, nil
+		}
+	}
+	return JalaliDate{}, err
+}
+
+// Placeholder selects how bind parameters are written in generated SQL
+type Placeholder int
+
+const (
+	// PlaceholderQuestion writes parameters as ? (MySQL, SQLite)
+	PlaceholderQuestion Placeholder = iota
+
+	// PlaceholderDollar writes parameters as $1, $2, ... (PostgreSQL)
+	PlaceholderDollar
+
+	// PlaceholderAt writes parameters as @p1, @p2, ... (SQL Server)
+	PlaceholderAt
+)
+
+// RangeFilter builds parameterized SQL conditions that restrict a date or
+// timestamp column to a Jalali period. Conditions are half-open,
+// column >= start AND column < end, so they work for DATE and TIMESTAMP
+// columns alike.
+//
+// A RangeFilter is immutable; the configuration methods return a copy.
+type RangeFilter struct {
+	column      string
+	loc         *time.Location
+	placeholder Placeholder
+	firstArg    int
+}
+
+// NewRangeFilter creates a RangeFilter for the given column. By default
+// bounds are computed in UTC and parameters are written as ?.
+func NewRangeFilter(column string) RangeFilter {
+	return RangeFilter{column: column, loc: time.UTC, firstArg: 1}
+}
+
+// In returns a copy of f that computes bounds in loc
+func (f RangeFilter) In(loc *time.Location) RangeFilter {
+	f.loc = loc
+	return f
+}
+
+// WithPlaceholder returns a copy of f that writes parameters in the given style
+func (f RangeFilter) WithPlaceholder(p Placeholder) RangeFilter {
+	f.placeholder = p
+	return f
+}
+
+// StartAt returns a copy of f whose numbered placeholders begin at n.
+// Use it when the condition follows other parameters in the same query.
+func (f RangeFilter) StartAt(n int) RangeFilter {
+	f.firstArg = n
+	return f
+}
+
+// Range returns the SQL condition and its arguments for a Range
+func (f RangeFilter) Range(r Range) (string, []any, error) {
+	start, end, err := r.Bounds(f.loc)
+	if err != nil {
+		return "", nil, err
+	}
+	cond := fmt.Sprintf("%s >= %s AND %s < %s",
+		f.column, f.param(0), f.column, f.param(1))
+	return cond, []any{start, end}, nil
+}
+
+// Year returns the SQL condition and its arguments for a Jalali year
+func (f RangeFilter) Year(year int) (string, []any, error) {
+	return f.Range(YearRange(year))
+}
+
+// Month returns the SQL condition and its arguments for a Jalali month
+func (f RangeFilter) Month(year, month int) (string, []any, error) {
+	r, err := MonthRange(year, month)
+	if err != nil {
+		return "", nil, err
+	}
+	return f.Range(r)
+}
+
+// Quarter returns the SQL condition and its arguments for a Jalali quarter
+func (f RangeFilter) Quarter(year, quarter int) (string, []any, error) {
+	r, err := QuarterRange(year, quarter)
+	if err != nil {
+		return "", nil, err
+	}
+	return f.Range(r)
+}
+
+// Week returns the SQL condition and its arguments for the Saturday-to-Friday
+// week containing j
+func (f RangeFilter) Week(j JalaliDate) (string, []any, error) {
+	return f.Range(WeekRange(j))
+}
+
+// param returns the i-th (zero-based) placeholder of the condition
+func (f RangeFilter) param(i int) string {
+	switch f.placeholder {
+	case PlaceholderDollar:
+		return fmt.Sprintf("$%d", f.firstArg+i)
+	case PlaceholderAt:
+		return fmt.Sprintf("@p%d", f.firstArg+i)
+	default:
+		return "?"
+	}
+}
@@ -0,0 +1,310 @@
+package persiancal
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestJalaliDateScan(t *testing.T) {
+	want := JalaliDate{Year: 1404, Month: 8, Day: 4}
+	tests := []struct {
+		name string
+		src  any
+	}{
+		{"time", time.Date(2025, 10, 26, 0, 0, 0, 0, time.UTC)},
+		{"time with clock", time.Date(2025, 10, 26, 23, 59, 0, 0, time.UTC)},
+		{"date string", "2025-10-26"},
+		{"datetime bytes", []byte("2025-10-26 00:00:00")},
+		{"rfc3339 string", "2025-10-26T12:30:00Z"},
+	}
+	for _, tt := range tests {
+		var j JalaliDate
+		if err := j.Scan(tt.src); err != nil {
+			t.Errorf("%s: Scan(%v) error = %v", tt.name, tt.src, err)
+			continue
+		}
+		if j != want {
+			t.Errorf("%s: Scan(%v) = %s, want %s", tt.name, tt.src, j, want)
+		}
+	}
+}
+
+func TestJalaliDateScanErrors(t *testing.T) {
+	tests := []struct {
+		src  any
+		want error
+	}{
+		{nil, ErrInvalidDate},
+		{42, ErrInvalidDate},
+		{"26/10/2025", ErrParseFailure},
+	}
+	for _, tt := range tests {
+		var j JalaliDate
+		if err := j.Scan(tt.src); !errors.Is(err, tt.want) {
+			t.Errorf("Scan(%v) error = %v, want %v", tt.src, err, tt.want)
+		}
+	}
+}
+
+func TestJalaliDateValueRoundTrip(t *testing.T) {
+	dates := []JalaliDate{
+		{1404, 8, 4},
+		{1403, 12, 30},
+		{1404, 1, 1},
+		{1, 1, 1},
+		{9999, 12, 29},
+	}
+	for _, j := range dates {
+		v, err := j.Value()
+		if err != nil {
+			t.Errorf("%s.Value() error = %v", j, err)
+			continue
+		}
+		var got JalaliDate
+		if err := got.Scan(v); err != nil {
+			t.Errorf("Scan(%s.Value()) error = %v", j, err)
+			continue
+		}
+		if got != j {
+			t.Errorf("Scan(%s.Value()) = %s", j, got)
+		}
+	}
+
+	v, _ := JalaliDate{1404, 8, 4}.Value()
+	if got := v.(time.Time).Format(time.DateOnly); got != "2025-10-26" {
+		t.Errorf("1404/08/04 Value() = %s, want 2025-10-26", got)
+	}
+	if _, err := (JalaliDate{1404, 12, 30}).Value(); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("1404/12/30 Value() error = %v, want ErrInvalidDay", err)
+	}
+}
+
+func TestNullJalaliDate(t *testing.T) {
+	var n NullJalaliDate
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %+v, %v; want invalid, nil", n, err)
+	}
+	if v, err := n.Value(); v != nil || err != nil {
+		t.Errorf("null Value() = %v, %v; want nil, nil", v, err)
+	}
+
+	if err := n.Scan("2025-10-26"); err != nil || !n.Valid {
+		t.Fatalf("Scan(2025-10-26) = %+v, %v", n, err)
+	}
+	if n.JalaliDate != (JalaliDate{1404, 8, 4}) {
+		t.Errorf("Scan(2025-10-26) = %s, want 1404/08/04", n.JalaliDate)
+	}
+	v, err := n.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	var back NullJalaliDate
+	if err := back.Scan(v); err != nil || back != n {
+		t.Errorf("round trip = %+v, %v; want %+v", back, err, n)
+	}
+}
+
+func TestJalaliText(t *testing.T) {
+	want := JalaliDate{Year: 1404, Month: 8, Day: 4}
+	for _, src := range []any{"1404/08/04", []byte("1404-08-04"), "1404.08.04", "۱۴۰۴/۰۸/۰۴"} {
+		var j JalaliText
+		if err := j.Scan(src); err != nil {
+			t.Errorf("Scan(%v) error = %v", src, err)
+			continue
+		}
+		if j.JalaliDate != want {
+			t.Errorf("Scan(%v) = %s, want %s", src, j.JalaliDate, want)
+		}
+	}
+
+	v, err := JalaliText{want}.Value()
+	if err != nil || v != "1404/08/04" {
+		t.Errorf("Value() = %v, %v; want 1404/08/04", v, err)
+	}
+
+	var n NullJalaliText
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %+v, %v; want invalid, nil", n, err)
+	}
+	if err := n.Scan(v); err != nil || !n.Valid || n.JalaliText.JalaliDate != want {
+		t.Errorf("Scan(%v) = %+v, %v", v, n, err)
+	}
+}
+
+// memDriver is a database/sql driver over an in-memory table with a single
+// DATE column. Its statements only understand the condition written by
+// RangeFilter: rows are returned when the column is within [args[0], args[1]).
+type memDriver struct {
+	rows []time.Time
+}
+
+func (d *memDriver) Open(string) (driver.Conn, error) { return memConn{d}, nil }
+
+type memConn struct{ d *memDriver }
+
+func (c memConn) Prepare(query string) (driver.Stmt, error) {
+	return memStmt{c.d, query}, nil
+}
+func (memConn) Close() error              { return nil }
+func (memConn) Begin() (driver.Tx, error) { return nil, errors.New("memdb: no transactions") }
+
+type memStmt struct {
+	d     *memDriver
+	query string
+}
+
+func (memStmt) Close() error  { return nil }
+func (memStmt) NumInput() int { return -1 }
+func (memStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("memdb: read only")
+}
+
+func (s memStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("memdb: %q: got %d args, want 2", s.query, len(args))
+	}
+	start, ok1 := args[0].(time.Time)
+	end, ok2 := args[1].(time.Time)
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("memdb: bounds %T, %T are not times", args[0], args[1])
+	}
+	var rows []time.Time
+	for _, t := range s.d.rows {
+		if !t.Before(start) && t.Before(end) {
+			rows = append(rows, t)
+		}
+	}
+	return &memRows{rows: rows}, nil
+}
+
+type memRows struct {
+	rows []time.Time
+}
+
+func (*memRows) Columns() []string { return []string{"d"} }
+func (*memRows) Close() error      { return nil }
+func (r *memRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	dest[0], r.rows = r.rows[0], r.rows[1:]
+	return nil
+}
+
+// openMemDB returns a database holding one row per day from 1403/12/25
+// through 1404/01/10 and 1404/08/01 through 1404/08/10, at midnight UTC
+// and at 23:59 of the same day
+func openMemDB(t *testing.T) *sql.DB {
+	t.Helper()
+	d := &memDriver{}
+	for _, r := range []Range{
+		{JalaliDate{1403, 12, 25}, JalaliDate{1404, 1, 10}},
+		{JalaliDate{1404, 8, 1}, JalaliDate{1404, 8, 10}},
+	} {
+		for j := r.Start; !j.After(r.End); j = j.AddDays(1) {
+			g := j.ToGregorian()
+			d.rows = append(d.rows, g, g.Add(23*time.Hour+59*time.Minute))
+		}
+	}
+	db := sql.OpenDB(memConnector{d})
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+type memConnector struct{ d *memDriver }
+
+func (c memConnector) Connect(context.Context) (driver.Conn, error) { return memConn{c.d}, nil }
+func (c memConnector) Driver() driver.Driver                        { return c.d }
+
+func TestRangeFilterQuery(t *testing.T) {
+	db := openMemDB(t)
+	f := NewRangeFilter("d")
+
+	week := func(j JalaliDate) func() (string, []any, error) {
+		return func() (string, []any, error) { return f.Week(j) }
+	}
+	tests := []struct {
+		name        string
+		filter      func() (string, []any, error)
+		first, last JalaliDate
+		rows        int
+	}{
+		{"year 1403", func() (string, []any, error) { return f.Year(1403) },
+			JalaliDate{1403, 12, 25}, JalaliDate{1403, 12, 30}, 12},
+		{"month 1404/01", func() (string, []any, error) { return f.Month(1404, 1) },
+			JalaliDate{1404, 1, 1}, JalaliDate{1404, 1, 10}, 20},
+		{"quarter 1404/3", func() (string, []any, error) { return f.Quarter(1404, 3) },
+			JalaliDate{1404, 8, 1}, JalaliDate{1404, 8, 10}, 20},
+		{"week of 1404/08/04", week(JalaliDate{1404, 8, 4}),
+			JalaliDate{1404, 8, 3}, JalaliDate{1404, 8, 9}, 14},
+	}
+	for _, tt := range tests {
+		cond, args, err := tt.filter()
+		if err != nil {
+			t.Errorf("%s: error = %v", tt.name, err)
+			continue
+		}
+		rows, err := db.Query("SELECT d FROM t WHERE "+cond, args...)
+		if err != nil {
+			t.Errorf("%s: Query error = %v", tt.name, err)
+			continue
+		}
+		var got []JalaliDate
+		for rows.Next() {
+			var j JalaliDate
+			if err := rows.Scan(&j); err != nil {
+				t.Fatalf("%s: Scan error = %v", tt.name, err)
+			}
+			got = append(got, j)
+		}
+		rows.Close()
+		if len(got) != tt.rows {
+			t.Errorf("%s: got %d rows, want %d", tt.name, len(got), tt.rows)
+			continue
+		}
+		if got[0] != tt.first || got[len(got)-1] != tt.last {
+			t.Errorf("%s: rows %s..%s, want %s..%s", tt.name, got[0], got[len(got)-1], tt.first, tt.last)
+		}
+	}
+}
+
+func TestRangeFilterCondition(t *testing.T) {
+	tests := []struct {
+		f    RangeFilter
+		want string
+	}{
+		{NewRangeFilter("created_at"), "created_at >= ? AND created_at < ?"},
+		{NewRangeFilter("d").WithPlaceholder(PlaceholderDollar), "d >= $1 AND d < $2"},
+		{NewRangeFilter("d").WithPlaceholder(PlaceholderDollar).StartAt(3), "d >= $3 AND d < $4"},
+		{NewRangeFilter("d").WithPlaceholder(PlaceholderAt), "d >= @p1 AND d < @p2"},
+	}
+	for _, tt := range tests {
+		cond, _, err := tt.f.Month(1404, 8)
+		if err != nil || cond != tt.want {
+			t.Errorf("Month(1404, 8) = %q, %v; want %q", cond, err, tt.want)
+		}
+	}
+
+	f := NewRangeFilter("d").In(TehranLocation())
+	_, args, err := f.Month(1404, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, end := args[0].(time.Time), args[1].(time.Time)
+	if got := start.UTC().Format(time.RFC3339); got != "2025-10-22T20:30:00Z" {
+		t.Errorf("Tehran start = %s, want 2025-10-22T20:30:00Z", got)
+	}
+	if got := end.UTC().Format(time.RFC3339); got != "2025-11-21T20:30:00Z" {
+		t.Errorf("Tehran end = %s, want 2025-11-21T20:30:00Z", got)
+	}
+
+	if _, _, err := f.Month(1404, 13); !errors.Is(err, ErrInvalidMonth) {
+		t.Errorf("Month(1404, 13) error = %v, want ErrInvalidMonth", err)
+	}
+}
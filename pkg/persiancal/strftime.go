@@ -0,0 +1,251 @@
+package persiancal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// strftimeExpansions are the directives that stand for other directives
+var strftimeExpansions = map[byte]string{
+	'F': "%Y-%m-%d",
+	'T': "%H:%M:%S",
+	'R': "%H:%M",
+}
+
+// Strftime formats t, converted to the Jalali calendar in t's location, with
+// the directives of C strftime, read in Jalali terms:
+//   - %Y: year (e.g., 1404); %y: two-digit year (e.g., 04)
+//   - %m: month (01-12); %d: day (01-31); %e: day, space padded
+//   - %B: Persian month name (e.g., آبان); %b, %h: English month name (e.g., Aban)
+//   - %A: Persian weekday name (e.g., شنبه); %a: English weekday name
+//   - %j: day of the Jalali year (001-366)
+//   - %U: Persian week of the year (00-53). Weeks start on Saturday and the
+//     days before the first Saturday are in week 0.
+//   - %w: weekday (0-6, Sunday is 0); %u: weekday (1-7, Monday is 1)
+//   - %H: hour (00-23); %I: hour (01-12); %M: minute; %S: second; %p: AM or PM
+//   - %F: %Y-%m-%d; %T: %H:%M:%S; %R: %H:%M
+//   - %n: newline; %t: tab; %%: a literal %
+//
+// Unknown directives are copied to the output unchanged, as glibc does.
+//
+//	persiancal.Strftime(t, "%A %d %B %Y, %H:%M") // یکشنبه 04 آبان 1404, 14:30
+func Strftime(t time.Time, format string) string {
+	j := FromGregorianDate(t)
+	format = expandStrftime(format)
+
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' || i+1 == len(format) {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		b.WriteString(strftimeField(format[i], j, t))
+	}
+	return b.String()
+}
+
+// expandStrftime replaces the directives in strftimeExpansions
+func expandStrftime(format string) string {
+	if !strings.Contains(format, "%") {
+		return format
+	}
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		if exp, ok := strftimeExpansions[format[i]]; ok {
+			b.WriteString(exp)
+		} else {
+			b.WriteString(format[i-1 : i+1])
+		}
+	}
+	return b.String()
+}
+
+// Strftime formats the date with strftime directives. Time directives are
+// formatted as midnight.
+func (j JalaliDate) Strftime(format string) string {
+	return Strftime(j.ToGregorian(), format)
+}
+
+// strftimeField renders a single directive
+func strftimeField(c byte, j JalaliDate, t time.Time) string {
+	switch c {
+	case 'Y':
+		return fmt.Sprintf("%d", j.Year)
+	case 'y':
+		return fmt.Sprintf("%02d", floorMod(j.Year, 100))
+	case 'm':
+		return fmt.Sprintf("%02d", j.Month)
+	case 'd':
+		return fmt.Sprintf("%02d", j.Day)
+	case 'e':
+		return fmt.Sprintf("%2d", j.Day)
+	case 'B':
+		return j.MonthName()
+	case 'b', 'h':
+		return j.MonthNameEnglish()
+	case 'A':
+		return j.WeekdayName()
+	case 'a':
+		return j.WeekdayNameEnglish()[:3]
+	case 'j':
+		return fmt.Sprintf("%03d", j.DayOfYear())
+	case 'U':
+		return fmt.Sprintf("%02d", j.PersianWeekOfYear())
+	case 'w':
+		return fmt.Sprintf("%d", j.DayOfWeek())
+	case 'u':
+		return fmt.Sprintf("%d", (int(j.DayOfWeek())+6)%7+1)
+	case 'H':
+		return fmt.Sprintf("%02d", t.Hour())
+	case 'I':
+		return fmt.Sprintf("%02d", (t.Hour()+11)%12+1)
+	case 'M':
+		return fmt.Sprintf("%02d", t.Minute())
+	case 'S':
+		return fmt.Sprintf("%02d", t.Second())
+	case 'p':
+		if t.Hour() < 12 {
+			return "AM"
+		}
+		return "PM"
+	case 'n':
+		return "\n"
+	case 't':
+		return "\t"
+	case '%':
+		return "%"
+	}
+	return "%" + string(c)
+}
+
+// PersianWeekOfYear returns the week of the Jalali year (0-53). Weeks start
+// on Saturday and the days before the first Saturday of the year are in
+// week 0.
+func (j JalaliDate) PersianWeekOfYear() int {
+	return (j.DayOfYear() - 1 + 7 - daysSinceSaturday(j.DayOfWeek())) / 7
+}
+
+// Strptime parses a value formatted with the directives of Strftime and
+// returns the time it describes in loc. A nil location is treated as UTC.
+// Both Persian and Latin digits are accepted, whitespace in the format
+// matches any amount of whitespace, and %y is resolved as by Parse, from
+// the current date. %j gives the date when the month and day are missing;
+// %U and %u are read but not checked. Errors for values that do not match
+// the format are of type *ParseError.
+func Strptime(format, value string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	format = expandStrftime(format)
+
+	p := newParser(format, value)
+	v := timeFields{hour: -1}
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		switch {
+		case isSpace(c):
+			p.skipValueSpace()
+			continue
+		case c != '%' || i+1 == len(format):
+			if p.vi >= len(p.latin) || p.latin[p.vi] != c {
+				return time.Time{}, p.errorf("", fmt.Sprintf("'%c'", c))
+			}
+			p.vi++
+			continue
+		}
+		i++
+		if err := v.parseDirective(p, format[i]); err != nil {
+			return time.Time{}, err
+		}
+	}
+	if p.vi < len(p.latin) {
+		return time.Time{}, p.errorf("", "end of input")
+	}
+
+	return v.time(loc)
+}
+
+// skipValueSpace skips whitespace in the value
+func (p *parser) skipValueSpace() {
+	for p.vi < len(p.latin) && isSpace(p.latin[p.vi]) {
+		p.vi++
+	}
+}
+
+// parseDirective reads the value of a single strftime directive
+func (v *timeFields) parseDirective(p *parser, c byte) error {
+	token := "%" + string(c)
+	var err error
+	switch c {
+	case 'Y':
+		v.year, err = p.number(token, 1, 4)
+	case 'y':
+		var yy int
+		yy, err = p.number(token, 2, 2)
+		v.year = ParseOptions{}.expandYear(yy, SolarHijriCalendar)
+	case 'm':
+		v.month, err = p.number(token, 1, 2)
+	case 'd', 'e':
+		p.skipValueSpace()
+		v.day, err = p.number(token, 1, 2)
+	case 'B':
+		v.month, err = p.name(token, monthNames(GetMonthNamePersian), false)
+	case 'b', 'h':
+		v.month, err = p.name(token, monthNames(GetMonthNameEnglish), true)
+	case 'A', 'a':
+		var wd int
+		if c == 'A' {
+			wd, err = p.name(token, weekdayNameList(GetWeekdayNamePersian), false)
+		} else {
+			wd, err = p.name(token, weekdayAbbreviations(), true)
+		}
+		v.weekday, v.hasWeekday = time.Weekday(wd), true
+	case 'j':
+		v.yearDay, err = p.number(token, 1, 3)
+	case 'U':
+		_, err = p.number(token, 1, 2)
+	case 'w', 'u':
+		_, err = p.number(token, 1, 1)
+	case 'H':
+		v.hour, err = p.number(token, 1, 2)
+	case 'I':
+		v.hour, err = p.number(token, 1, 2)
+		v.hour12 = true
+	case 'M':
+		v.minute, err = p.number(token, 1, 2)
+	case 'S':
+		v.second, err = p.number(token, 1, 2)
+	case 'p':
+		var i int
+		i, err = p.name(token, []string{"AM", "PM"}, true)
+		v.pm, v.hasPeriod = i == 1, true
+	case 'n', 't':
+		p.skipValueSpace()
+	case '%':
+		if p.vi >= len(p.latin) || p.latin[p.vi] != '%' {
+			return p.errorf("", "'%'")
+		}
+		p.vi++
+	default:
+		return fmt.Errorf("%w: unsupported directive %s", ErrInvalidLayout, token)
+	}
+	return err
+}
+
+// weekdayAbbreviations returns the three-letter English weekday names,
+// indexed by time.Weekday
+func weekdayAbbreviations() []string {
+	names := weekdayNameList(GetWeekdayNameEnglish)
+	for i, n := range names {
+		names[i] = n[:3]
+	}
+	return names
+}
@@ -0,0 +1,48 @@
+package persiancal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStrftime(t *testing.T) {
+	tm := time.Date(2025, 10, 26, 14, 30, 5, 0, time.UTC)
+	tests := []struct {
+		format, want string
+	}{
+		{"%A %d %B %Y, %H:%M", "یکشنبه 04 آبان 1404, 14:30"},
+		{"%Y/%m/%d, day %j, week %U", "1404/08/04, day 220, week 32"},
+		{"%a %e %b %y", "Sun  4 Aban 04"},
+		{"%F %T", "1404-08-04 14:30:05"},
+		{"%I:%M %p, %w %u", "02:30 PM, 0 7"},
+		{"100%% %q", "100% %q"},
+	}
+	for _, tt := range tests {
+		if got := Strftime(tm, tt.format); got != tt.want {
+			t.Errorf("Strftime(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestStrptime(t *testing.T) {
+	tehran := TehranLocation()
+	tests := []struct {
+		format, value string
+		want          time.Time
+	}{
+		{"%d %b %Y %H:%M", "4 Aban 1404 14:30", time.Date(2025, 10, 26, 14, 30, 0, 0, tehran)},
+		{"%Y/%m/%d", "۱۴۰۴/۰۸/۰۴", time.Date(2025, 10, 26, 0, 0, 0, 0, tehran)},
+		{"%Y %j", "1403 366", time.Date(2025, 3, 20, 0, 0, 0, 0, tehran)},
+		{"%F  %I:%M %p", "1404-01-01 11:59 PM", time.Date(2025, 3, 21, 23, 59, 0, 0, tehran)},
+	}
+	for _, tt := range tests {
+		got, err := Strptime(tt.format, tt.value, tehran)
+		if err != nil {
+			t.Errorf("Strptime(%q, %q) error = %v", tt.format, tt.value, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Strptime(%q, %q) = %s, want %s", tt.format, tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package persiancal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Locale selects the language of formatted dates. Region subtags are
+// ignored, so "fa-IR" is the same as LocalePersian.
+type Locale string
+
+// Supported locales
+const (
+	LocalePersian Locale = "fa"
+	LocaleEnglish Locale = "en"
+)
+
+// language returns the base language of the locale, defaulting to English
+func (l Locale) language() Locale {
+	base, _, _ := strings.Cut(strings.ReplaceAll(string(l), "_", "-"), "-")
+	if Locale(strings.ToLower(base)) == LocalePersian {
+		return LocalePersian
+	}
+	return LocaleEnglish
+}
+
+// localize converts the digits of s for the locale
+func (l Locale) localize(s string) string {
+	if l.language() == LocalePersian {
+		return ToPersianDigits(s)
+	}
+	return s
+}
+
+// Style is a named length of date format
+type Style int
+
+const (
+	// StyleShort is a compact numeric date, e.g. 04/08/04
+	StyleShort Style = iota
+
+	// StyleMedium is a numeric date with the full year, e.g. 1404/08/04
+	StyleMedium
+
+	// StyleLong has the month name, e.g. 04 آبان 1404
+	StyleLong
+
+	// StyleFull has the weekday and month name, e.g. یکشنبه ۴ آبان ۱۴۰۴
+	StyleFull
+)
+
+// String returns the name of the style
+func (s Style) String() string {
+	switch s {
+	case StyleShort:
+		return "short"
+	case StyleMedium:
+		return "medium"
+	case StyleLong:
+		return "long"
+	case StyleFull:
+		return "full"
+	default:
+		return fmt.Sprintf("Style(%d)", int(s))
+	}
+}
+
+// eraEnglish is appended to long English dates, as in "4 Aban 1404 AP"
+const eraEnglish = " AP"
+
+// styleLayouts holds the layout of each style per locale
+var styleLayouts = map[Locale][4]string{
+	LocalePersian: {LayoutShort, LayoutSlash, LayoutLong, LayoutFull},
+	LocaleEnglish: {LayoutShort, LayoutSlash, LayoutLongEnglish + eraEnglish, LayoutFullEnglish + eraEnglish},
+}
+
+// StyleLayout returns the layout used for a style in a locale
+func StyleLayout(style Style, locale Locale) string {
+	if style < StyleShort || style > StyleFull {
+		style = StyleMedium
+	}
+	return styleLayouts[locale.language()][style]
+}
+
+// FormatStyle formats the date in a named style for a locale. Persian
+// dates use Persian digits.
+//
+//	j.FormatStyle(persiancal.StyleFull, persiancal.LocalePersian) // یکشنبه ۴ آبان ۱۴۰۴
+//	j.FormatStyle(persiancal.StyleFull, persiancal.LocaleEnglish) // Sunday, 4 Aban 1404 AP
+func (j JalaliDate) FormatStyle(style Style, locale Locale) string {
+	return locale.localize(j.Format(StyleLayout(style, locale)))
+}
+
+// skeletonLayouts holds the layout of each canonical skeleton per locale
+var skeletonLayouts = map[Locale]map[string]string{
+	LocalePersian: {
+		"d":      "d",
+		"Ed":     "EEEE d",
+		"M":      "M",
+		"MMM":    "MMMM",
+		"Md":     "M/d",
+		"MEd":    "EEEE M/d",
+		"MMMd":   "d MMMM",
+		"MMMEd":  "EEEE d MMMM",
+		"y":      "yyyy",
+		"yM":     "yyyy/M",
+		"yMd":    "yyyy/M/d",
+		"yMEd":   "EEEE yyyy/M/d",
+		"yMMM":   "MMMM yyyy",
+		"yMMMd":  "d MMMM yyyy",
+		"yMMMEd": LayoutFull,
+	},
+	LocaleEnglish: {
+		"d":      "d",
+		"Ed":     "d EEE",
+		"M":      "M",
+		"MMM":    "MMM",
+		"Md":     "M/d",
+		"MEd":    "EEE, M/d",
+		"MMMd":   "d MMM",
+		"MMMEd":  "EEE, d MMM",
+		"y":      "yyyy" + eraEnglish,
+		"yM":     "M/yyyy" + eraEnglish,
+		"yMd":    "M/d/yyyy" + eraEnglish,
+		"yMEd":   "EEE, M/d/yyyy" + eraEnglish,
+		"yMMM":   "MMM yyyy" + eraEnglish,
+		"yMMMd":  "d MMM yyyy" + eraEnglish,
+		"yMMMEd": LayoutFullEnglish + eraEnglish,
+	},
+}
+
+// SkeletonLayout returns the layout that best fits a CLDR skeleton in a
+// locale. A skeleton lists the fields to show, in any order, without
+// punctuation: y for the year, M or MM for the month number, MMM or MMMM for
+// the month name, E for the weekday and d for the day. Returns an error
+// wrapping ErrInvalidLayout for unsupported skeletons.
+func SkeletonLayout(skeleton string, locale Locale) (string, error) {
+	key, err := canonicalSkeleton(skeleton)
+	if err != nil {
+		return "", err
+	}
+	layout, ok := skeletonLayouts[locale.language()][key]
+	if !ok {
+		return "", fmt.Errorf("%w: unsupported skeleton %q", ErrInvalidLayout, skeleton)
+	}
+	return layout, nil
+}
+
+// FormatSkeleton formats the date with the layout that best fits a CLDR
+// skeleton in a locale
+//
+//	j.FormatSkeleton("yMMMEd", persiancal.LocaleEnglish) // Sunday, 4 Aban 1404 AP
+//	j.FormatSkeleton("MMMd", persiancal.LocalePersian)   // ۴ آبان
+func (j JalaliDate) FormatSkeleton(skeleton string, locale Locale) (string, error) {
+	layout, err := SkeletonLayout(skeleton, locale)
+	if err != nil {
+		return "", err
+	}
+	return locale.localize(j.Format(layout)), nil
+}
+
+// canonicalSkeleton reduces a skeleton to the keys of skeletonLayouts:
+// fields in the order y, M, E, d, with the month as M or MMM
+func canonicalSkeleton(skeleton string) (string, error) {
+	counts := map[rune]int{}
+	for _, r := range skeleton {
+		switch r {
+		case 'y', 'M', 'E', 'd':
+			counts[r]++
+		default:
+			return "", fmt.Errorf("%w: unsupported skeleton field %q in %q", ErrInvalidLayout, r, skeleton)
+		}
+	}
+
+	var b strings.Builder
+	if counts['y'] > 0 {
+		b.WriteByte('y')
+	}
+	switch {
+	case counts['M'] >= 3:
+		b.WriteString("MMM")
+	case counts['M'] > 0:
+		b.WriteByte('M')
+	}
+	if counts['E'] > 0 {
+		b.WriteByte('E')
+	}
+	if counts['d'] > 0 {
+		b.WriteByte('d')
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,107 @@
+package persiancal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFormatStyle(t *testing.T) {
+	// 4 Aban 1404 is a Sunday and 3 Aban a Saturday
+	sunday := JalaliDate{1404, 8, 4}
+	saturday := JalaliDate{1404, 8, 3}
+	tests := []struct {
+		j      JalaliDate
+		style  Style
+		locale Locale
+		want   string
+	}{
+		{sunday, StyleFull, LocalePersian, "یکشنبه ۴ آبان ۱۴۰۴"},
+		{sunday, StyleFull, LocaleEnglish, "Sunday, 4 Aban 1404 AP"},
+		{saturday, StyleFull, LocalePersian, "شنبه ۳ آبان ۱۴۰۴"},
+		{saturday, StyleFull, LocaleEnglish, "Saturday, 3 Aban 1404 AP"},
+		{sunday, StyleLong, LocalePersian, "۰۴ آبان ۱۴۰۴"},
+		{sunday, StyleLong, LocaleEnglish, "04 Aban 1404 AP"},
+		{sunday, StyleMedium, LocalePersian, "۱۴۰۴/۰۸/۰۴"},
+		{sunday, StyleMedium, LocaleEnglish, "1404/08/04"},
+		{sunday, StyleShort, LocalePersian, "۰۴/۰۸/۰۴"},
+		{sunday, StyleShort, LocaleEnglish, "04/08/04"},
+
+		// Region subtags are ignored and unknown languages use English
+		{sunday, StyleFull, "fa-IR", "یکشنبه ۴ آبان ۱۴۰۴"},
+		{sunday, StyleFull, "FA_ir", "یکشنبه ۴ آبان ۱۴۰۴"},
+		{sunday, StyleFull, "en-GB", "Sunday, 4 Aban 1404 AP"},
+		{sunday, StyleFull, "de", "Sunday, 4 Aban 1404 AP"},
+		{sunday, StyleFull, "", "Sunday, 4 Aban 1404 AP"},
+
+		// Unknown styles are medium
+		{sunday, Style(7), LocalePersian, "۱۴۰۴/۰۸/۰۴"},
+		{sunday, Style(-1), LocaleEnglish, "1404/08/04"},
+	}
+	for _, tt := range tests {
+		if got := tt.j.FormatStyle(tt.style, tt.locale); got != tt.want {
+			t.Errorf("%s.FormatStyle(%s, %q) = %q, want %q", tt.j, tt.style, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestStyleString(t *testing.T) {
+	tests := []struct {
+		s    Style
+		want string
+	}{
+		{StyleShort, "short"},
+		{StyleMedium, "medium"},
+		{StyleLong, "long"},
+		{StyleFull, "full"},
+		{Style(9), "Style(9)"},
+	}
+	for _, tt := range tests {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("Style(%d).String() = %q, want %q", int(tt.s), got, tt.want)
+		}
+	}
+}
+
+func TestFormatSkeleton(t *testing.T) {
+	j := JalaliDate{1404, 8, 4}
+	tests := []struct {
+		skeleton string
+		fa, en   string
+	}{
+		{"yMMMEd", "یکشنبه ۴ آبان ۱۴۰۴", "Sunday, 4 Aban 1404 AP"},
+		{"yMMMd", "۴ آبان ۱۴۰۴", "4 Aban 1404 AP"},
+		{"yMMM", "آبان ۱۴۰۴", "Aban 1404 AP"},
+		{"yMEd", "یکشنبه ۱۴۰۴/۸/۴", "Sunday, 8/4/1404 AP"},
+		{"yMd", "۱۴۰۴/۸/۴", "8/4/1404 AP"},
+		{"yM", "۱۴۰۴/۸", "8/1404 AP"},
+		{"y", "۱۴۰۴", "1404 AP"},
+		{"MMMEd", "یکشنبه ۴ آبان", "Sunday, 4 Aban"},
+		{"MMMd", "۴ آبان", "4 Aban"},
+		{"MMM", "آبان", "Aban"},
+		{"MEd", "یکشنبه ۸/۴", "Sunday, 8/4"},
+		{"Md", "۸/۴", "8/4"},
+		{"M", "۸", "8"},
+		{"Ed", "یکشنبه ۴", "4 Sunday"},
+		{"d", "۴", "4"},
+
+		// Field order and repeated letters do not matter
+		{"dMy", "۱۴۰۴/۸/۴", "8/4/1404 AP"},
+		{"EEEEdMMMMy", "یکشنبه ۴ آبان ۱۴۰۴", "Sunday, 4 Aban 1404 AP"},
+		{"yyyyMMd", "۱۴۰۴/۸/۴", "8/4/1404 AP"},
+	}
+	for _, tt := range tests {
+		if got, err := j.FormatSkeleton(tt.skeleton, LocalePersian); err != nil || got != tt.fa {
+			t.Errorf("FormatSkeleton(%q, fa) = %q, %v; want %q", tt.skeleton, got, err, tt.fa)
+		}
+		if got, err := j.FormatSkeleton(tt.skeleton, LocaleEnglish); err != nil || got != tt.en {
+			t.Errorf("FormatSkeleton(%q, en) = %q, %v; want %q", tt.skeleton, got, err, tt.en)
+		}
+	}
+
+	// Unsupported fields and combinations
+	for _, skeleton := range []string{"", "yQ", "hm", "yMMM d", "E", "yE", "yd"} {
+		if got, err := j.FormatSkeleton(skeleton, LocalePersian); !errors.Is(err, ErrInvalidLayout) {
+			t.Errorf("FormatSkeleton(%q) = %q, %v; want ErrInvalidLayout", skeleton, got, err)
+		}
+	}
+}
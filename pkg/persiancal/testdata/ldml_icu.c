@@ -0,0 +1,80 @@
+/*
+ * ldml_icu regenerates the output column of ldml.golden with ICU4C's
+ * SimpleDateFormat and the Persian calendar, so that the golden file
+ * records what ICU prints rather than what FormatLDML prints:
+ *
+ *   cc -o /tmp/ldml_icu ldml_icu.c $(pkg-config --cflags --libs icu-i18n)
+ *   /tmp/ldml_icu < ldml.golden > ldml.golden.new
+ *
+ * Comment and blank lines are copied unchanged, so the header stating the
+ * ICU version must be updated by hand.
+ */
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+#include <time.h>
+
+#include <unicode/udat.h>
+#include <unicode/ustring.h>
+
+#define BUFSIZE 1024
+
+static void check(UErrorCode status, const char *what) {
+	if (U_FAILURE(status)) {
+		fprintf(stderr, "ldml_icu: %s: %s\n", what, u_errorName(status));
+		exit(1);
+	}
+}
+
+/* parseRFC3339 reads a UTC time of the form 2006-01-02T15:04:05Z */
+static UDate parseRFC3339(const char *s) {
+	struct tm tm = {0};
+	if (sscanf(s, "%d-%d-%dT%d:%d:%dZ", &tm.tm_year, &tm.tm_mon, &tm.tm_mday,
+	           &tm.tm_hour, &tm.tm_min, &tm.tm_sec) != 6) {
+		fprintf(stderr, "ldml_icu: bad time %s\n", s);
+		exit(1);
+	}
+	tm.tm_year -= 1900;
+	tm.tm_mon -= 1;
+	return (UDate)timegm(&tm) * 1000.0;
+}
+
+int main(void) {
+	char line[BUFSIZE];
+	while (fgets(line, sizeof line, stdin)) {
+		line[strcspn(line, "\n")] = 0;
+		if (line[0] == 0 || line[0] == '#') {
+			puts(line);
+			continue;
+		}
+
+		char *ts = strtok(line, "\t");
+		char *lang = strtok(NULL, "\t");
+		char *pattern = strtok(NULL, "\t");
+		if (!ts || !lang || !pattern) {
+			fprintf(stderr, "ldml_icu: bad row\n");
+			return 1;
+		}
+
+		char locale[64];
+		snprintf(locale, sizeof locale, "%s@calendar=persian", lang);
+
+		UErrorCode status = U_ZERO_ERROR;
+		UChar upattern[BUFSIZE], out[BUFSIZE];
+		u_strFromUTF8(upattern, BUFSIZE, NULL, pattern, -1, &status);
+		check(status, "pattern");
+
+		UDateFormat *f = udat_open(UDAT_PATTERN, UDAT_PATTERN, locale,
+		                           u"UTC", -1, upattern, -1, &status);
+		check(status, "udat_open");
+		udat_format(f, parseRFC3339(ts), out, BUFSIZE, NULL, &status);
+		check(status, "udat_format");
+		udat_close(f);
+
+		char result[BUFSIZE * 4];
+		u_strToUTF8(result, sizeof result, NULL, out, -1, &status);
+		check(status, "result");
+		printf("%s\t%s\t%s\t%s\n", ts, lang, pattern, result);
+	}
+	return 0;
+}
@@ -12,9 +12,11 @@ type JalaliDate struct {
 	Day   int // 1-31
 }
 
-// Now returns the current date in the Jalali calendar
+// Now returns the current date in the Jalali calendar, in the location of
+// the package clock (the local time zone for SystemClock). Use Today or
+// NowIn when the result must not depend on the machine's time zone.
 func Now() JalaliDate {
-	return FromGregorianDate(time.Now())
+	return FromGregorianDate(CurrentClock().Now())
 }
 
 // FromGregorianDate converts a Gregorian time.Time to JalaliDate
@@ -37,6 +39,12 @@ func (j JalaliDate) ToGregorian() time.Time {
 	return ToGregorian(j.Year, j.Month, j.Day)
 }
 
+// DayNumber returns the Julian Day Number of the date.
+// Returns an error if the date is invalid or outside the supported range.
+func (j JalaliDate) DayNumber() (DayNumber, error) {
+	return JalaliToDayNumber(j.Year, j.Month, j.Day)
+}
+
 // IsLeap returns true if the year is a leap year in the Jalali calendar
 func (j JalaliDate) IsLeap() bool {
 	return isJalaliLeap(j.Year)
@@ -49,53 +57,124 @@ func (j JalaliDate) String() string {
 
 // AddDays adds n days to the date and returns a new JalaliDate
 func (j JalaliDate) AddDays(n int) JalaliDate {
-	// Convert to Gregorian, add days, convert back
-	t := j.ToGregorian()
-	t = t.AddDate(0, 0, n)
-	return FromGregorianDate(t)
+	jy, jm, jd := jdnToJalali(jalaliToJDN(j.Year, j.Month, j.Day) + n)
+	return JalaliDate{Year: jy, Month: jm, Day: jd}
 }
 
-// AddMonths adds n months to the date and returns a new JalaliDate
+// AddMonths adds n months to the date and returns a new JalaliDate.
+// If the day does not exist in the target month it is clamped to the last
+// day of that month; use AddMonthsWith for other behaviours.
 func (j JalaliDate) AddMonths(n int) JalaliDate {
-	year := j.Year
-	month := j.Month + n
-	day := j.Day
+	result, _ := j.addMonths(n, OverflowClamp)
+	return result
+}
 
-	// Handle month overflow/underflow
-	for month > 12 {
-		month -= 12
-		year++
-	}
-	for month < 1 {
-		month += 12
-		year--
+// AddYears adds n years to the date and returns a new JalaliDate.
+// 30 Esfand becomes 29 Esfand when the target year is not a leap year;
+// use AddYearsWith for other behaviours.
+func (j JalaliDate) AddYears(n int) JalaliDate {
+	result, _ := j.addMonths(12*n, OverflowClamp)
+	return result
+}
+
+// OverflowPolicy decides what AddMonthsWith and AddYearsWith do when the
+// day of the month does not exist in the target month, such as 31 Shahrivar
+// plus one month, or 30 Esfand of a leap year plus one year.
+type OverflowPolicy int
+
+const (
+	// OverflowClamp moves the day back to the last day of the target month:
+	// 31 Shahrivar + 1 month is 30 Mehr, and 30 Esfand + 1 year is 29 Esfand
+	// when the target year is not a leap year. AddMonths and AddYears use it.
+	OverflowClamp OverflowPolicy = iota
+
+	// OverflowRoll carries the extra days into the following month:
+	// 31 Shahrivar + 1 month is 1 Aban, and 30 Esfand + 1 year is 1 Farvardin
+	// of the year after when the target year is not a leap year.
+	OverflowRoll
+
+	// OverflowError returns an error wrapping ErrInvalidDay instead of
+	// adjusting the day.
+	OverflowError
+
+	// OverflowStickyEnd keeps dates on the last day of their month at the
+	// end of the target month: 31 Shahrivar + 1 month is 30 Mehr and
+	// 30 Mehr + 1 month is 30 Aban, while 30 Mehr - 1 month is 31 Shahrivar.
+	// 29 Esfand of a common year moves to 30 Esfand in a leap year and
+	// 30 Esfand of a leap year moves to 29 Esfand in a common year. Other
+	// days are clamped.
+	OverflowStickyEnd
+)
+
+// String returns the name of the policy
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowClamp:
+		return "clamp"
+	case OverflowRoll:
+		return "roll"
+	case OverflowError:
+		return "error"
+	case OverflowStickyEnd:
+		return "sticky-end"
+	default:
+		return fmt.Sprintf("OverflowPolicy(%d)", int(p))
 	}
+}
 
-	// Adjust day if it exceeds the number of days in the new month
-	maxDay := daysInJalaliMonth(year, month)
-	if day > maxDay {
-		day = maxDay
+// AddMonthsWith adds n months to the date, resolving days that do not exist
+// in the target month according to policy. An error is returned if the date
+// is invalid, or if policy is OverflowError and the day does not exist.
+func (j JalaliDate) AddMonthsWith(n int, policy OverflowPolicy) (JalaliDate, error) {
+	if err := j.Validate(); err != nil {
+		return JalaliDate{}, err
 	}
+	return j.addMonths(n, policy)
+}
 
-	return JalaliDate{Year: year, Month: month, Day: day}
+// AddYearsWith adds n years to the date, resolving 30 Esfand in a target
+// year that is not a leap year according to policy
+func (j JalaliDate) AddYearsWith(n int, policy OverflowPolicy) (JalaliDate, error) {
+	return j.AddMonthsWith(12*n, policy)
 }
 
-// AddYears adds n years to the date and returns a new JalaliDate
-func (j JalaliDate) AddYears(n int) JalaliDate {
-	year := j.Year + n
-	month := j.Month
-	day := j.Day
+// addMonths shifts the date by n months and applies policy to the day
+func (j JalaliDate) addMonths(n int, policy OverflowPolicy) (JalaliDate, error) {
+	// Handle month overflow/underflow
+	months := j.Month - 1 + n
+	year := j.Year + floorDiv(months, 12)
+	month := floorMod(months, 12) + 1
+
+	return resolveDay(year, month, j.Day, j.Day == daysInJalaliMonth(j.Year, j.Month), policy)
+}
 
-	// Adjust day if it's Feb 30 in a leap year becoming non-leap
+// resolveDay builds the date year/month/day, applying policy when day is
+// past the end of the month. endOfMonth reports whether the source date was
+// the last day of its month, for OverflowStickyEnd.
+func resolveDay(year, month, day int, endOfMonth bool, policy OverflowPolicy) (JalaliDate, error) {
 	maxDay := daysInJalaliMonth(year, month)
-	if day > maxDay {
-		day = maxDay
+
+	if policy == OverflowStickyEnd && endOfMonth {
+		return JalaliDate{Year: year, Month: month, Day: maxDay}, nil
+	}
+	if day <= maxDay {
+		return JalaliDate{Year: year, Month: month, Day: day}, nil
 	}
 
-	return JalaliDate{Year: year, Month: month, Day: day}
+	switch policy {
+	case OverflowRoll:
+		return JalaliDate{Year: year, Month: month, Day: 1}.AddDays(day - 1), nil
+	case OverflowError:
+		return JalaliDate{}, fmt.Errorf("%w: %s %d has %d days, not %d",
+			ErrInvalidDay, GetMonthNameEnglish(month), year, maxDay, day)
+	default:
+		return JalaliDate{Year: year, Month: month, Day: maxDay}, nil
+	}
 }
 
-// Sub returns the duration between two JalaliDates
+// Sub returns the duration between two JalaliDates.
+// Like time.Time.Sub, the result saturates for dates more than about
+// 292 years apart; use DaysBetween for an exact day count.
 func (j JalaliDate) Sub(other JalaliDate) time.Duration {
 	t1 := j.ToGregorian()
 	t2 := other.ToGregorian()
@@ -104,9 +183,7 @@ func (j JalaliDate) Sub(other JalaliDate) time.Duration {
 
 // DaysBetween returns the number of days between two JalaliDates
 func (j JalaliDate) DaysBetween(other JalaliDate) int {
-	duration := j.Sub(other)
-	days := int(duration.Hours() / 24)
-	return days
+	return jalaliToJDN(j.Year, j.Month, j.Day) - jalaliToJDN(other.Year, other.Month, other.Day)
 }
 
 // Before returns true if j is before other
@@ -138,6 +215,10 @@ func (j JalaliDate) Equal(other JalaliDate) bool {
 
 // Validate checks if the JalaliDate is valid
 func (j JalaliDate) Validate() error {
+	if j.Year < MinYear || j.Year > MaxYear {
+		return ErrOutOfRange
+	}
+
 	if j.Month < 1 || j.Month > 12 {
 		return ErrInvalidMonth
 	}
@@ -152,7 +233,7 @@ func (j JalaliDate) Validate() error {
 
 // DayOfWeek returns the day of the week (0 = Sunday, 6 = Saturday)
 func (j JalaliDate) DayOfWeek() time.Weekday {
-	return j.ToGregorian().Weekday()
+	return DayNumber(jalaliToJDN(j.Year, j.Month, j.Day)).Weekday()
 }
 
 // DayOfYear returns the day of the year (1-365 or 1-366)
@@ -203,3 +284,13 @@ func (j JalaliDate) MonthName() string {
 func (j JalaliDate) MonthNameEnglish() string {
 	return GetMonthNameEnglish(j.Month)
 }
+
+// WeekdayName returns the Persian name of the day of the week
+func (j JalaliDate) WeekdayName() string {
+	return GetWeekdayNamePersian(j.DayOfWeek())
+}
+
+// WeekdayNameEnglish returns the English name of the day of the week
+func (j JalaliDate) WeekdayNameEnglish() string {
+	return GetWeekdayNameEnglish(j.DayOfWeek())
+}
@@ -0,0 +1,109 @@
+package persiancal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAddMonthsWith(t *testing.T) {
+	shahrivar31 := JalaliDate{1404, 6, 31}
+	mehr30 := JalaliDate{1404, 7, 30}
+	tests := []struct {
+		j      JalaliDate
+		n      int
+		clamp  JalaliDate
+		roll   JalaliDate
+		sticky JalaliDate
+	}{
+		{shahrivar31, 1, JalaliDate{1404, 7, 30}, JalaliDate{1404, 8, 1}, JalaliDate{1404, 7, 30}},
+		{shahrivar31, 2, JalaliDate{1404, 8, 30}, JalaliDate{1404, 9, 1}, JalaliDate{1404, 8, 30}},
+		// 1404 is a common year, so Esfand has 29 days and the roll goes
+		// two days into 1405
+		{shahrivar31, 6, JalaliDate{1404, 12, 29}, JalaliDate{1405, 1, 2}, JalaliDate{1404, 12, 29}},
+		{shahrivar31, -9, JalaliDate{1403, 9, 30}, JalaliDate{1403, 10, 1}, JalaliDate{1403, 9, 30}},
+		{shahrivar31, -1, JalaliDate{1404, 5, 31}, JalaliDate{1404, 5, 31}, JalaliDate{1404, 5, 31}},
+		{shahrivar31, -5, JalaliDate{1404, 1, 31}, JalaliDate{1404, 1, 31}, JalaliDate{1404, 1, 31}},
+
+		// Only the sticky end keeps the last day of Mehr on the last day
+		{mehr30, -1, JalaliDate{1404, 6, 30}, JalaliDate{1404, 6, 30}, JalaliDate{1404, 6, 31}},
+		{mehr30, 1, JalaliDate{1404, 8, 30}, JalaliDate{1404, 8, 30}, JalaliDate{1404, 8, 30}},
+		{JalaliDate{1404, 6, 30}, 1, JalaliDate{1404, 7, 30}, JalaliDate{1404, 7, 30}, JalaliDate{1404, 7, 30}},
+	}
+	for _, tt := range tests {
+		for _, c := range []struct {
+			policy OverflowPolicy
+			want   JalaliDate
+		}{
+			{OverflowClamp, tt.clamp},
+			{OverflowRoll, tt.roll},
+			{OverflowStickyEnd, tt.sticky},
+		} {
+			if got, err := tt.j.AddMonthsWith(tt.n, c.policy); err != nil || got != c.want {
+				t.Errorf("%s.AddMonthsWith(%d, %s) = %s, %v; want %s", tt.j, tt.n, c.policy, got, err, c.want)
+			}
+		}
+
+		// OverflowError succeeds exactly when no adjustment is needed
+		got, err := tt.j.AddMonthsWith(tt.n, OverflowError)
+		if tt.clamp == tt.roll {
+			if err != nil || got != tt.clamp {
+				t.Errorf("%s.AddMonthsWith(%d, error) = %s, %v; want %s", tt.j, tt.n, got, err, tt.clamp)
+			}
+		} else if !errors.Is(err, ErrInvalidDay) {
+			t.Errorf("%s.AddMonthsWith(%d, error) = %s, %v; want ErrInvalidDay", tt.j, tt.n, got, err)
+		}
+	}
+}
+
+func TestAddYearsWithEsfand30(t *testing.T) {
+	// 1403 and 1408 are leap years, 1404 and 1407 are not
+	tests := []struct {
+		j                   JalaliDate
+		n                   int
+		clamp, roll, sticky JalaliDate
+		overflows           bool
+	}{
+		{JalaliDate{1403, 12, 30}, 1, JalaliDate{1404, 12, 29}, JalaliDate{1405, 1, 1}, JalaliDate{1404, 12, 29}, true},
+		{JalaliDate{1403, 12, 30}, -1, JalaliDate{1402, 12, 29}, JalaliDate{1403, 1, 1}, JalaliDate{1402, 12, 29}, true},
+		{JalaliDate{1403, 12, 30}, 5, JalaliDate{1408, 12, 30}, JalaliDate{1408, 12, 30}, JalaliDate{1408, 12, 30}, false},
+		// 29 Esfand is the last day of a common year
+		{JalaliDate{1407, 12, 29}, 1, JalaliDate{1408, 12, 29}, JalaliDate{1408, 12, 29}, JalaliDate{1408, 12, 30}, false},
+		{JalaliDate{1404, 12, 29}, -1, JalaliDate{1403, 12, 29}, JalaliDate{1403, 12, 29}, JalaliDate{1403, 12, 30}, false},
+	}
+	for _, tt := range tests {
+		for _, c := range []struct {
+			policy OverflowPolicy
+			want   JalaliDate
+		}{
+			{OverflowClamp, tt.clamp},
+			{OverflowRoll, tt.roll},
+			{OverflowStickyEnd, tt.sticky},
+		} {
+			if got, err := tt.j.AddYearsWith(tt.n, c.policy); err != nil || got != c.want {
+				t.Errorf("%s.AddYearsWith(%d, %s) = %s, %v; want %s", tt.j, tt.n, c.policy, got, err, c.want)
+			}
+		}
+
+		got, err := tt.j.AddYearsWith(tt.n, OverflowError)
+		if tt.overflows {
+			if !errors.Is(err, ErrInvalidDay) {
+				t.Errorf("%s.AddYearsWith(%d, error) = %s, %v; want ErrInvalidDay", tt.j, tt.n, got, err)
+			}
+		} else if err != nil || got != tt.clamp {
+			t.Errorf("%s.AddYearsWith(%d, error) = %s, %v; want %s", tt.j, tt.n, got, err, tt.clamp)
+		}
+	}
+
+	// AddYears clamps
+	if got := (JalaliDate{1403, 12, 30}).AddYears(1); got != (JalaliDate{1404, 12, 29}) {
+		t.Errorf("1403/12/30.AddYears(1) = %s, want 1404/12/29", got)
+	}
+}
+
+func TestAddMonthsWithInvalidDate(t *testing.T) {
+	for _, policy := range []OverflowPolicy{OverflowClamp, OverflowRoll, OverflowError, OverflowStickyEnd} {
+		if _, err := (JalaliDate{1404, 12, 30}).AddMonthsWith(1, policy); !errors.Is(err, ErrInvalidDay) {
+			t.Errorf("1404/12/30.AddMonthsWith(1, %s) error = %v, want ErrInvalidDay", policy, err)
+		}
+	}
+}
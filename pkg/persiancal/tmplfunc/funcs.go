@@ -0,0 +1,160 @@
+// Package tmplfunc provides text/template and html/template functions for
+// printing dates in the Jalali calendar.
+package tmplfunc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/CHashtager/persiancal/pkg/persiancal"
+)
+
+// FuncMap returns template functions for Jalali dates. It can be passed to
+// the Funcs method of both text/template and html/template templates.
+//
+// Every function takes the date as its last argument, so it can end a
+// pipeline. The date may be a time.Time, a JalaliDate, a Day, pointers to
+// them, or a string: RFC 3339 timestamps and any date read by
+// persiancal.ParseAny. Times are converted in their own location.
+//
+//   - jalali: the date as a JalaliDate, printed as yyyy/MM/dd
+//   - jformat LAYOUT: the date formatted with JalaliDate.Format
+//   - jpersian LAYOUT: the same with Persian digits
+//   - jbidi LAYOUT: jpersian wrapped in FSI…PDI, for mixed-direction text
+//   - jhuman: the date relative to today in Tehran, e.g. ۳ روز پیش
+//   - jweekday: the Persian weekday name
+//
+// The functions return plain strings, so html/template escapes them for
+// the context they appear in.
+//
+//	t := template.Must(template.New("").Funcs(tmplfunc.FuncMap()).Parse(
+//		`{{.Created | jpersian "d MMMM yyyy"}} ({{jhuman .Created}})`))
+func FuncMap() map[string]any {
+	return map[string]any{
+		"jalali":   Jalali,
+		"jformat":  jformat,
+		"jpersian": jpersian,
+		"jbidi":    jbidi,
+		"jhuman":   jhuman,
+		"jweekday": jweekday,
+	}
+}
+
+// Jalali converts a template value to a JalaliDate. Returns an error for
+// unsupported types and for dates outside the supported range.
+func Jalali(v any) (persiancal.JalaliDate, error) {
+	var j persiancal.JalaliDate
+	switch v := v.(type) {
+	case persiancal.JalaliDate:
+		j = v
+	case *persiancal.JalaliDate:
+		if v == nil {
+			return j, fmt.Errorf("nil *JalaliDate")
+		}
+		j = *v
+	case persiancal.Day:
+		j = v.JalaliDate()
+	case time.Time:
+		j = persiancal.FromGregorianDate(v)
+	case *time.Time:
+		if v == nil {
+			return j, fmt.Errorf("nil *time.Time")
+		}
+		j = persiancal.FromGregorianDate(*v)
+	case string:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			j = persiancal.FromGregorianDate(t)
+			break
+		}
+		r, err := persiancal.ParseAny(v, nil)
+		if err != nil {
+			return j, err
+		}
+		j = r.Date
+	default:
+		return j, fmt.Errorf("cannot use %T as a date", v)
+	}
+	if err := j.Validate(); err != nil {
+		return persiancal.JalaliDate{}, err
+	}
+	return j, nil
+}
+
+func jformat(layout string, v any) (string, error) {
+	j, err := Jalali(v)
+	if err != nil {
+		return "", err
+	}
+	return j.Format(layout), nil
+}
+
+func jpersian(layout string, v any) (string, error) {
+	j, err := Jalali(v)
+	if err != nil {
+		return "", err
+	}
+	return j.FormatPersian(layout), nil
+}
+
+func jbidi(layout string, v any) (string, error) {
+	s, err := jpersian(layout, v)
+	if err != nil {
+		return "", err
+	}
+	return persiancal.BidiIsolate.Wrap(s), nil
+}
+
+func jweekday(v any) (string, error) {
+	j, err := Jalali(v)
+	if err != nil {
+		return "", err
+	}
+	return j.WeekdayName(), nil
+}
+
+func jhuman(v any) (string, error) {
+	j, err := Jalali(v)
+	if err != nil {
+		return "", err
+	}
+	return Human(j, persiancal.TodayIn(persiancal.TehranLocation())), nil
+}
+
+// Human describes j relative to ref in Persian: امروز, دیروز and فردا for
+// adjacent days, then days, weeks, months and years, e.g. ۳ روز پیش or
+// ۲ ماه دیگر
+func Human(j, ref persiancal.JalaliDate) string {
+	days := j.DaysBetween(ref)
+	switch days {
+	case 0:
+		return "امروز"
+	case -1:
+		return "دیروز"
+	case 1:
+		return "فردا"
+	}
+
+	suffix := "دیگر"
+	if days < 0 {
+		suffix = "پیش"
+		days = -days
+	}
+	p := persiancal.PeriodBetween(ref, j)
+	if p.Years < 0 || p.Months < 0 {
+		p = p.Negate()
+	}
+
+	var n int
+	var unit string
+	switch {
+	case days < 7:
+		n, unit = days, "روز"
+	case p.Years == 0 && p.Months == 0:
+		n, unit = days/7, "هفته"
+	case p.Years == 0:
+		n, unit = p.Months, "ماه"
+	default:
+		n, unit = p.Years, "سال"
+	}
+	return persiancal.ToPersianDigits(fmt.Sprintf("%d %s %s", n, unit, suffix))
+}
@@ -0,0 +1,137 @@
+package tmplfunc
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/CHashtager/persiancal/pkg/persiancal"
+)
+
+// execText runs src as a text/template with FuncMap and data
+func execText(t *testing.T, src string, data any) (string, error) {
+	t.Helper()
+	tmpl, err := texttemplate.New("").Funcs(FuncMap()).Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	err = tmpl.Execute(&b, data)
+	return b.String(), err
+}
+
+// execHTML runs src as an html/template with FuncMap and data
+func execHTML(t *testing.T, src string, data any) (string, error) {
+	t.Helper()
+	tmpl, err := htmltemplate.New("").Funcs(FuncMap()).Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	err = tmpl.Execute(&b, data)
+	return b.String(), err
+}
+
+func TestFuncMap(t *testing.T) {
+	// Today is 1404/08/07 in Tehran
+	defer persiancal.SetClock(persiancal.SetClock(persiancal.FixedClock(
+		time.Date(2025, 10, 29, 12, 0, 0, 0, time.UTC))))
+
+	j := persiancal.JalaliDate{Year: 1404, Month: 8, Day: 4}
+	g := time.Date(2025, 10, 26, 9, 0, 0, 0, time.UTC)
+	d, err := persiancal.NewDay(1404, 8, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs := []any{j, &j, d, g, &g, "2025-10-26T09:00:00Z", "1404/08/04", "26/10/2025"}
+
+	tests := []struct {
+		src, want string
+	}{
+		{`{{jalali .}}`, "1404/08/04"},
+		{`{{jformat "d MMMM yyyy" .}}`, "4 آبان 1404"},
+		{`{{. | jformat "yyyy-MM-dd"}}`, "1404-08-04"},
+		{`{{jpersian "d MMMM yyyy" .}}`, "۴ آبان ۱۴۰۴"},
+		{`{{jbidi "d MMMM" .}}`, "\u2068۴ آبان\u2069"},
+		{`{{jhuman .}}`, "۳ روز پیش"},
+		{`{{jweekday .}}`, "یکشنبه"},
+	}
+	for _, in := range inputs {
+		for _, tt := range tests {
+			got, err := execText(t, tt.src, in)
+			if err != nil || got != tt.want {
+				t.Errorf("text %s with %T %v = %q, %v; want %q", tt.src, in, in, got, err, tt.want)
+			}
+			got, err = execHTML(t, tt.src, in)
+			if err != nil || got != tt.want {
+				t.Errorf("html %s with %T %v = %q, %v; want %q", tt.src, in, in, got, err, tt.want)
+			}
+		}
+	}
+}
+
+func TestFuncMapHTMLEscaping(t *testing.T) {
+	j := persiancal.JalaliDate{Year: 1404, Month: 8, Day: 4}
+	tests := []struct {
+		src, text, html string
+	}{
+		// The isolates are not HTML-special and pass through unchanged
+		{`<p>{{jbidi "d MMMM" .}}</p>`, "<p>\u2068۴ آبان\u2069</p>", "<p>\u2068۴ آبان\u2069</p>"},
+		{`<p title="{{jbidi "d MMMM" .}}">`, "<p title=\"\u2068۴ آبان\u2069\">", "<p title=\"\u2068۴ آبان\u2069\">"},
+		{`<a href="/d/{{jbidi "yyyy" .}}">`, "<a href=\"/d/\u2068۱۴۰۴\u2069\">", "<a href=\"/d/%e2%81%a8%db%b1%db%b4%db%b0%db%b4%e2%81%a9\">"},
+		{`<script>var d = {{jbidi "d MMMM" .}};</script>`, "<script>var d = \u2068۴ آبان\u2069;</script>", "<script>var d = \"\u2068۴ آبان\u2069\";</script>"},
+		// Layout text is output like the date, so html/template escapes it
+		{`{{jformat "<b>yyyy</b> & MM" .}}`, "<b>1404</b> & 08", "&lt;b&gt;1404&lt;/b&gt; &amp; 08"},
+	}
+	for _, tt := range tests {
+		if got, err := execText(t, tt.src, j); err != nil || got != tt.text {
+			t.Errorf("text %s = %q, %v; want %q", tt.src, got, err, tt.text)
+		}
+		if got, err := execHTML(t, tt.src, j); err != nil || got != tt.html {
+			t.Errorf("html %s = %q, %v; want %q", tt.src, got, err, tt.html)
+		}
+	}
+}
+
+func TestFuncMapErrors(t *testing.T) {
+	var nilDate *persiancal.JalaliDate
+	var nilTime *time.Time
+	for _, in := range []any{
+		nilDate,
+		nilTime,
+		42,
+		"tomorrow",
+		persiancal.JalaliDate{Year: 1404, Month: 12, Day: 30},
+		time.Date(500, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		if got, err := execText(t, `{{jformat "yyyy" .}}`, in); err == nil {
+			t.Errorf("jformat with %T %v = %q, want an error", in, in, got)
+		}
+		if got, err := execHTML(t, `{{jweekday .}}`, in); err == nil {
+			t.Errorf("jweekday with %T %v = %q, want an error", in, in, got)
+		}
+	}
+}
+
+func TestHuman(t *testing.T) {
+	ref := persiancal.JalaliDate{Year: 1404, Month: 8, Day: 4}
+	tests := []struct {
+		j    persiancal.JalaliDate
+		want string
+	}{
+		{ref, "امروز"},
+		{persiancal.JalaliDate{Year: 1404, Month: 8, Day: 3}, "دیروز"},
+		{persiancal.JalaliDate{Year: 1404, Month: 8, Day: 5}, "فردا"},
+		{persiancal.JalaliDate{Year: 1404, Month: 8, Day: 10}, "۶ روز دیگر"},
+		{persiancal.JalaliDate{Year: 1404, Month: 8, Day: 18}, "۲ هفته دیگر"},
+		{persiancal.JalaliDate{Year: 1404, Month: 6, Day: 1}, "۲ ماه پیش"},
+		{persiancal.JalaliDate{Year: 1401, Month: 8, Day: 4}, "۳ سال پیش"},
+	}
+	for _, tt := range tests {
+		if got := Human(tt.j, ref); got != tt.want {
+			t.Errorf("Human(%s, %s) = %q, want %q", tt.j, ref, got, tt.want)
+		}
+	}
+}
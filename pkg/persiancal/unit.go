@@ -0,0 +1,152 @@
+package persiancal
+
+import "fmt"
+
+// Unit is a calendar period used for truncating, rounding and navigating
+// dates. The methods that take a Unit panic if it is not one of the
+// constants below.
+type Unit int
+
+const (
+	// Week is a Saturday-to-Friday week
+	Week Unit = iota + 1
+
+	// Month is a Jalali month
+	Month
+
+	// Quarter is three Jalali months, starting with Farvardin, Tir, Mehr and Dey
+	Quarter
+
+	// HalfYear is six Jalali months, starting with Farvardin and Mehr
+	HalfYear
+
+	// Year is a Jalali year
+	Year
+)
+
+// String returns the name of the unit
+func (u Unit) String() string {
+	switch u {
+	case Week:
+		return "week"
+	case Month:
+		return "month"
+	case Quarter:
+		return "quarter"
+	case HalfYear:
+		return "half-year"
+	case Year:
+		return "year"
+	default:
+		return fmt.Sprintf("Unit(%d)", int(u))
+	}
+}
+
+// months returns the number of months spanned by a month-based unit, or
+// zero for Week and invalid units
+func (u Unit) months() int {
+	switch u {
+	case Month:
+		return 1
+	case Quarter:
+		return 3
+	case HalfYear:
+		return 6
+	case Year:
+		return 12
+	default:
+		return 0
+	}
+}
+
+// Truncate returns the first day of the unit containing j: the Saturday of
+// its week, or the first day of its month, quarter, half-year or year
+func (j JalaliDate) Truncate(u Unit) JalaliDate {
+	if u == Week {
+		return j.AddDays(-daysSinceSaturday(j.DayOfWeek()))
+	}
+	span := u.months()
+	if span == 0 {
+		panic("persiancal: invalid " + u.String())
+	}
+	month := (j.Month-1)/span*span + 1
+	return JalaliDate{Year: j.Year, Month: month, Day: 1}
+}
+
+// Ceil returns the first day of the unit after j, unless j is already the
+// first day of its unit, in which case j is returned
+func (j JalaliDate) Ceil(u Unit) JalaliDate {
+	start := j.Truncate(u)
+	if start.Equal(j) {
+		return j
+	}
+	return start.Next(u)
+}
+
+// Round returns whichever of Truncate and Ceil is nearer to j, counting
+// days. Halfway values round up, as in time.Time.Round.
+func (j JalaliDate) Round(u Unit) JalaliDate {
+	down := j.Truncate(u)
+	up := j.Ceil(u)
+	if j.DaysBetween(down) < up.DaysBetween(j) {
+		return down
+	}
+	return up
+}
+
+// Next returns the first day of the unit following the one containing j
+func (j JalaliDate) Next(u Unit) JalaliDate {
+	return j.Truncate(u).shift(u, 1)
+}
+
+// Prev returns the first day of the unit preceding the one containing j
+func (j JalaliDate) Prev(u Unit) JalaliDate {
+	return j.Truncate(u).shift(u, -1)
+}
+
+// shift moves a unit-aligned date by n units
+func (j JalaliDate) shift(u Unit, n int) JalaliDate {
+	if u == Week {
+		return j.AddDays(7 * n)
+	}
+	return j.AddMonths(u.months() * n)
+}
+
+// UnitRange returns the range covering the unit containing j
+func UnitRange(j JalaliDate, u Unit) Range {
+	start := j.Truncate(u)
+	return Range{Start: start, End: start.Next(u).AddDays(-1)}
+}
+
+// Truncate returns the first day of the unit containing d
+func (d Day) Truncate(u Unit) Day {
+	return d.viaDate(func(j JalaliDate) JalaliDate { return j.Truncate(u) })
+}
+
+// Ceil returns the first day of the unit after d, unless d is already the
+// first day of its unit
+func (d Day) Ceil(u Unit) Day {
+	return d.viaDate(func(j JalaliDate) JalaliDate { return j.Ceil(u) })
+}
+
+// Round returns whichever of Truncate and Ceil is nearer to d.
+// Halfway values round up.
+func (d Day) Round(u Unit) Day {
+	return d.viaDate(func(j JalaliDate) JalaliDate { return j.Round(u) })
+}
+
+// Next returns the first day of the unit following the one containing d
+func (d Day) Next(u Unit) Day {
+	return d.viaDate(func(j JalaliDate) JalaliDate { return j.Next(u) })
+}
+
+// Prev returns the first day of the unit preceding the one containing d
+func (d Day) Prev(u Unit) Day {
+	return d.viaDate(func(j JalaliDate) JalaliDate { return j.Prev(u) })
+}
+
+// viaDate applies a JalaliDate operation to d
+func (d Day) viaDate(f func(JalaliDate) JalaliDate) Day {
+	j := d.JalaliDate()
+	return d.Add(f(j).DaysBetween(j))
+}
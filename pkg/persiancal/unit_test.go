@@ -0,0 +1,104 @@
+package persiancal
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnitYearEnd(t *testing.T) {
+	// 1403 is a leap year with an Esfand 30, 1404 is not
+	tests := []struct {
+		j         JalaliDate
+		u         Unit
+		truncate  JalaliDate
+		next      JalaliDate
+		prev      JalaliDate
+		rangeLast JalaliDate
+	}{
+		{JalaliDate{1403, 12, 30}, Month, JalaliDate{1403, 12, 1}, JalaliDate{1404, 1, 1}, JalaliDate{1403, 11, 1}, JalaliDate{1403, 12, 30}},
+		{JalaliDate{1403, 12, 29}, Quarter, JalaliDate{1403, 10, 1}, JalaliDate{1404, 1, 1}, JalaliDate{1403, 7, 1}, JalaliDate{1403, 12, 30}},
+		{JalaliDate{1403, 12, 30}, HalfYear, JalaliDate{1403, 7, 1}, JalaliDate{1404, 1, 1}, JalaliDate{1403, 1, 1}, JalaliDate{1403, 12, 30}},
+		{JalaliDate{1403, 12, 30}, Year, JalaliDate{1403, 1, 1}, JalaliDate{1404, 1, 1}, JalaliDate{1402, 1, 1}, JalaliDate{1403, 12, 30}},
+		{JalaliDate{1404, 12, 29}, Month, JalaliDate{1404, 12, 1}, JalaliDate{1405, 1, 1}, JalaliDate{1404, 11, 1}, JalaliDate{1404, 12, 29}},
+		{JalaliDate{1404, 12, 29}, Quarter, JalaliDate{1404, 10, 1}, JalaliDate{1405, 1, 1}, JalaliDate{1404, 7, 1}, JalaliDate{1404, 12, 29}},
+		{JalaliDate{1404, 12, 29}, Year, JalaliDate{1404, 1, 1}, JalaliDate{1405, 1, 1}, JalaliDate{1403, 1, 1}, JalaliDate{1404, 12, 29}},
+
+		// Farvardin rollover: the first days of a year belong to a week
+		// that started in Esfand, and Prev crosses back into it
+		{JalaliDate{1404, 1, 1}, Week, JalaliDate{1403, 12, 25}, JalaliDate{1404, 1, 2}, JalaliDate{1403, 12, 18}, JalaliDate{1404, 1, 1}},
+		{JalaliDate{1405, 1, 3}, Week, JalaliDate{1405, 1, 1}, JalaliDate{1405, 1, 8}, JalaliDate{1404, 12, 23}, JalaliDate{1405, 1, 7}},
+		{JalaliDate{1404, 1, 15}, Month, JalaliDate{1404, 1, 1}, JalaliDate{1404, 2, 1}, JalaliDate{1403, 12, 1}, JalaliDate{1404, 1, 31}},
+		{JalaliDate{1404, 1, 1}, Year, JalaliDate{1404, 1, 1}, JalaliDate{1405, 1, 1}, JalaliDate{1403, 1, 1}, JalaliDate{1404, 12, 29}},
+	}
+	for _, tt := range tests {
+		if got := tt.j.Truncate(tt.u); got != tt.truncate {
+			t.Errorf("%s.Truncate(%s) = %s, want %s", tt.j, tt.u, got, tt.truncate)
+		}
+		if got := tt.j.Next(tt.u); got != tt.next {
+			t.Errorf("%s.Next(%s) = %s, want %s", tt.j, tt.u, got, tt.next)
+		}
+		if got := tt.j.Prev(tt.u); got != tt.prev {
+			t.Errorf("%s.Prev(%s) = %s, want %s", tt.j, tt.u, got, tt.prev)
+		}
+		r := UnitRange(tt.j, tt.u)
+		if r.Start != tt.truncate || r.End != tt.rangeLast {
+			t.Errorf("UnitRange(%s, %s) = %s, want %s - %s", tt.j, tt.u, r, tt.truncate, tt.rangeLast)
+		}
+
+		d, err := NewDay(tt.j.Year, tt.j.Month, tt.j.Day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := d.Next(tt.u).JalaliDate(); got != tt.next {
+			t.Errorf("Day %s.Next(%s) = %s, want %s", tt.j, tt.u, got, tt.next)
+		}
+	}
+}
+
+func TestUnitCeilRound(t *testing.T) {
+	tests := []struct {
+		j     JalaliDate
+		u     Unit
+		ceil  JalaliDate
+		round JalaliDate
+	}{
+		{JalaliDate{1404, 1, 1}, Year, JalaliDate{1404, 1, 1}, JalaliDate{1404, 1, 1}},
+		{JalaliDate{1403, 12, 30}, Month, JalaliDate{1404, 1, 1}, JalaliDate{1404, 1, 1}},
+		// 15 days either way in a 30-day Esfand: halfway rounds up
+		{JalaliDate{1403, 12, 16}, Month, JalaliDate{1404, 1, 1}, JalaliDate{1404, 1, 1}},
+		// A 29-day Esfand has no halfway day: the 15th is nearer its start
+		{JalaliDate{1404, 12, 15}, Month, JalaliDate{1405, 1, 1}, JalaliDate{1404, 12, 1}},
+		{JalaliDate{1404, 12, 16}, Month, JalaliDate{1405, 1, 1}, JalaliDate{1405, 1, 1}},
+		{JalaliDate{1404, 12, 28}, Week, JalaliDate{1405, 1, 1}, JalaliDate{1405, 1, 1}},
+	}
+	for _, tt := range tests {
+		if got := tt.j.Ceil(tt.u); got != tt.ceil {
+			t.Errorf("%s.Ceil(%s) = %s, want %s", tt.j, tt.u, got, tt.ceil)
+		}
+		if got := tt.j.Round(tt.u); got != tt.round {
+			t.Errorf("%s.Round(%s) = %s, want %s", tt.j, tt.u, got, tt.round)
+		}
+	}
+}
+
+func TestInvalidUnitPanics(t *testing.T) {
+	j := JalaliDate{1404, 8, 4}
+	for _, f := range []func(Unit){
+		func(u Unit) { j.Truncate(u) },
+		func(u Unit) { j.Next(u) },
+		func(u Unit) { j.Prev(u) },
+		func(u Unit) { UnitRange(j, u) },
+	} {
+		for _, u := range []Unit{0, Year + 1} {
+			func() {
+				defer func() {
+					r := recover()
+					if s, ok := r.(string); !ok || !strings.Contains(s, u.String()) {
+						t.Errorf("%s: recovered %v, want a panic naming the unit", u, r)
+					}
+				}()
+				f(u)
+			}()
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package persiancal
+
+import (
+	"fmt"
+	"iter"
+	"time"
+)
+
+// NthWeekdayOfMonth returns the n-th occurrence of weekday in a Jalali month.
+// n counts from 1 at the start of the month; negative n counts from the end,
+// so -1 is the last occurrence. An error wrapping ErrInvalidDate is returned
+// if the month has no such occurrence.
+//
+//	// Last Wednesday of Mehr 1404
+//	d, err := persiancal.NthWeekdayOfMonth(1404, 7, time.Wednesday, -1)
+func NthWeekdayOfMonth(year, month int, weekday time.Weekday, n int) (JalaliDate, error) {
+	if month < 1 || month > 12 {
+		return JalaliDate{}, ErrInvalidMonth
+	}
+
+	start := JalaliDate{Year: year, Month: month, Day: 1}
+	var day int
+	switch {
+	case n > 0:
+		offset := (int(weekday) - int(start.DayOfWeek()) + 7) % 7
+		day = 1 + offset + 7*(n-1)
+	case n < 0:
+		end := start.EndOfMonth()
+		offset := (int(end.DayOfWeek()) - int(weekday) + 7) % 7
+		day = end.Day - offset + 7*(n+1)
+	}
+
+	if day < 1 || day > DaysInMonth(year, month) {
+		return JalaliDate{}, fmt.Errorf("%w: %s %d has no occurrence %d of %s",
+			ErrInvalidDate, GetMonthNameEnglish(month), year, n, weekday)
+	}
+	return JalaliDate{Year: year, Month: month, Day: day}, nil
+}
+
+// NextWeekday returns the first date after j that falls on weekday.
+// If j itself falls on weekday, the date a week later is returned.
+func (j JalaliDate) NextWeekday(weekday time.Weekday) JalaliDate {
+	offset := (int(weekday) - int(j.DayOfWeek()) + 7) % 7
+	if offset == 0 {
+		offset = 7
+	}
+	return j.AddDays(offset)
+}
+
+// PrevWeekday returns the last date before j that falls on weekday.
+// If j itself falls on weekday, the date a week earlier is returned.
+func (j JalaliDate) PrevWeekday(weekday time.Weekday) JalaliDate {
+	offset := (int(j.DayOfWeek()) - int(weekday) + 7) % 7
+	if offset == 0 {
+		offset = 7
+	}
+	return j.AddDays(-offset)
+}
+
+// NearestWeekday returns the date closest to j that falls on weekday,
+// which is j itself if it already falls on weekday. The nearest occurrence
+// is always less than four days away, so there is never a tie.
+func (j JalaliDate) NearestWeekday(weekday time.Weekday) JalaliDate {
+	offset := (int(weekday) - int(j.DayOfWeek()) + 7) % 7
+	if offset > 3 {
+		offset -= 7
+	}
+	return j.AddDays(offset)
+}
+
+// AllWeekdaysInYear returns an iterator over every date in a Jalali year
+// that falls on weekday, in order
+//
+//	for d := range persiancal.AllWeekdaysInYear(1404, time.Friday) {
+//		fmt.Println(d)
+//	}
+func AllWeekdaysInYear(year int, weekday time.Weekday) iter.Seq[JalaliDate] {
+	return func(yield func(JalaliDate) bool) {
+		start := JalaliDate{Year: year, Month: 1, Day: 1}
+		d := start.NearestWeekday(weekday)
+		if d.Before(start) {
+			d = d.AddDays(7)
+		}
+		end := start.EndOfYear()
+		for !d.After(end) {
+			if !yield(d) {
+				return
+			}
+			d = d.AddDays(7)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package persiancal
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	// Mehr 1404 has 30 days and starts on a Tuesday, Farvardin 1404 has 31
+	// and starts on a Friday, and Esfand 1404 has 29 and ends on a Friday
+	tests := []struct {
+		year, month int
+		weekday     time.Weekday
+		n           int
+		want        int // day of the month, 0 for none
+	}{
+		{1404, 7, time.Tuesday, 1, 1},
+		{1404, 7, time.Wednesday, 1, 2},
+		{1404, 7, time.Monday, 1, 7},
+		{1404, 7, time.Wednesday, -1, 30},
+		{1404, 7, time.Thursday, -1, 24},
+		{1404, 7, time.Tuesday, 5, 29},
+		{1404, 7, time.Wednesday, 5, 30},
+		{1404, 7, time.Wednesday, -5, 2},
+		{1404, 7, time.Thursday, 5, 0},
+		{1404, 7, time.Thursday, -5, 0},
+		{1404, 7, time.Wednesday, 6, 0},
+		{1404, 7, time.Wednesday, -6, 0},
+		{1404, 7, time.Wednesday, 0, 0},
+		{1404, 1, time.Sunday, 5, 31},
+		{1404, 1, time.Monday, 5, 0},
+		{1404, 12, time.Friday, 5, 29},
+		{1404, 12, time.Friday, -5, 1},
+		{1404, 12, time.Saturday, -5, 0},
+		{1404, 12, time.Saturday, -4, 2},
+	}
+	for _, tt := range tests {
+		got, err := NthWeekdayOfMonth(tt.year, tt.month, tt.weekday, tt.n)
+		if tt.want == 0 {
+			if !errors.Is(err, ErrInvalidDate) {
+				t.Errorf("NthWeekdayOfMonth(%d, %d, %s, %d) = %s, %v; want ErrInvalidDate",
+					tt.year, tt.month, tt.weekday, tt.n, got, err)
+			}
+			continue
+		}
+		want := JalaliDate{tt.year, tt.month, tt.want}
+		if err != nil || got != want {
+			t.Errorf("NthWeekdayOfMonth(%d, %d, %s, %d) = %s, %v; want %s",
+				tt.year, tt.month, tt.weekday, tt.n, got, err, want)
+		}
+		if got.DayOfWeek() != tt.weekday {
+			t.Errorf("NthWeekdayOfMonth(%d, %d, %s, %d) = %s, a %s",
+				tt.year, tt.month, tt.weekday, tt.n, got, got.DayOfWeek())
+		}
+	}
+
+	for _, month := range []int{0, 13} {
+		if _, err := NthWeekdayOfMonth(1404, month, time.Friday, 1); !errors.Is(err, ErrInvalidMonth) {
+			t.Errorf("NthWeekdayOfMonth(1404, %d, ...) error = %v, want ErrInvalidMonth", month, err)
+		}
+	}
+}
+
+func TestNextPrevWeekday(t *testing.T) {
+	sunday := JalaliDate{1404, 8, 4}
+	tests := []struct {
+		j          JalaliDate
+		weekday    time.Weekday
+		next, prev JalaliDate
+	}{
+		// the same weekday is a week away in either direction
+		{sunday, time.Sunday, JalaliDate{1404, 8, 11}, JalaliDate{1404, 7, 27}},
+		{sunday, time.Monday, JalaliDate{1404, 8, 5}, JalaliDate{1404, 7, 28}},
+		{sunday, time.Saturday, JalaliDate{1404, 8, 10}, JalaliDate{1404, 8, 3}},
+		// 1404/12/29 is a Friday and the last day of the year
+		{JalaliDate{1404, 12, 29}, time.Saturday, JalaliDate{1405, 1, 1}, JalaliDate{1404, 12, 23}},
+		{JalaliDate{1405, 1, 1}, time.Friday, JalaliDate{1405, 1, 7}, JalaliDate{1404, 12, 29}},
+	}
+	for _, tt := range tests {
+		if got := tt.j.NextWeekday(tt.weekday); got != tt.next {
+			t.Errorf("%s.NextWeekday(%s) = %s, want %s", tt.j, tt.weekday, got, tt.next)
+		}
+		if got := tt.j.PrevWeekday(tt.weekday); got != tt.prev {
+			t.Errorf("%s.PrevWeekday(%s) = %s, want %s", tt.j, tt.weekday, got, tt.prev)
+		}
+	}
+}
+
+func TestNearestWeekday(t *testing.T) {
+	// A week has an odd number of days, so there are no ties: three days
+	// ahead is nearer than four days back
+	sunday := JalaliDate{1404, 8, 4}
+	tests := []struct {
+		weekday time.Weekday
+		want    JalaliDate
+	}{
+		{time.Sunday, sunday},
+		{time.Monday, JalaliDate{1404, 8, 5}},
+		{time.Tuesday, JalaliDate{1404, 8, 6}},
+		{time.Wednesday, JalaliDate{1404, 8, 7}},
+		{time.Thursday, JalaliDate{1404, 8, 1}},
+		{time.Friday, JalaliDate{1404, 8, 2}},
+		{time.Saturday, JalaliDate{1404, 8, 3}},
+	}
+	for _, tt := range tests {
+		got := sunday.NearestWeekday(tt.weekday)
+		if got != tt.want {
+			t.Errorf("%s.NearestWeekday(%s) = %s, want %s", sunday, tt.weekday, got, tt.want)
+		}
+		if d := got.DaysBetween(sunday); d < -3 || d > 3 {
+			t.Errorf("%s.NearestWeekday(%s) is %d days away", sunday, tt.weekday, d)
+		}
+	}
+}
+
+func TestAllWeekdaysInYear(t *testing.T) {
+	// 1403 is a leap year starting on a Wednesday, so it has 53 Wednesdays
+	// and Thursdays; 1404 is a common year starting on a Friday, so it has
+	// 53 Fridays. Every other weekday occurs 52 times.
+	tests := []struct {
+		year   int
+		extra  []time.Weekday
+		first  time.Weekday
+		length int
+	}{
+		{1403, []time.Weekday{time.Wednesday, time.Thursday}, time.Wednesday, 366},
+		{1404, []time.Weekday{time.Friday}, time.Friday, 365},
+	}
+	for _, tt := range tests {
+		if got := (JalaliDate{tt.year, 1, 1}).DayOfWeek(); got != tt.first {
+			t.Fatalf("%d starts on a %s, want %s", tt.year, got, tt.first)
+		}
+		total := 0
+		for wd := time.Sunday; wd <= time.Saturday; wd++ {
+			want := 52
+			for _, e := range tt.extra {
+				if wd == e {
+					want = 53
+				}
+			}
+
+			var prev JalaliDate
+			n := 0
+			for d := range AllWeekdaysInYear(tt.year, wd) {
+				if d.Year != tt.year || d.DayOfWeek() != wd {
+					t.Errorf("AllWeekdaysInYear(%d, %s) yields %s", tt.year, wd, d)
+				}
+				if n > 0 && d.DaysBetween(prev) != 7 {
+					t.Errorf("AllWeekdaysInYear(%d, %s) yields %s after %s", tt.year, wd, d, prev)
+				}
+				prev = d
+				n++
+			}
+			if n != want {
+				t.Errorf("AllWeekdaysInYear(%d, %s) yields %d dates, want %d", tt.year, wd, n, want)
+			}
+			total += n
+		}
+		if total != tt.length {
+			t.Errorf("AllWeekdaysInYear(%d, ...) yields %d dates in all, want %d", tt.year, total, tt.length)
+		}
+	}
+
+	// Stopping early
+	var got []JalaliDate
+	for d := range AllWeekdaysInYear(1404, time.Saturday) {
+		got = append(got, d)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if len(got) != 2 || got[0] != (JalaliDate{1404, 1, 2}) || got[1] != (JalaliDate{1404, 1, 9}) {
+		t.Errorf("first two Saturdays of 1404 = %v, want [1404/01/02 1404/01/09]", got)
+	}
+}
@@ -0,0 +1,209 @@
+package persiancal
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// YearMonth represents a month of a particular Jalali year, such as a
+// billing period
+type YearMonth struct {
+	Year  int
+	Month int // 1-12
+}
+
+// yearMonthTokens are the layout tokens understood by YearMonth
+var yearMonthTokens = []string{"yyyy", "yy", "MMMM", "MMM", "MM", "M"}
+
+// NewYearMonth creates a new YearMonth with validation
+func NewYearMonth(year, month int) (YearMonth, error) {
+	ym := YearMonth{Year: year, Month: month}
+	if err := ym.Validate(); err != nil {
+		return YearMonth{}, err
+	}
+	return ym, nil
+}
+
+// YearMonthOf returns the year and month of j
+func YearMonthOf(j JalaliDate) YearMonth {
+	return YearMonth{Year: j.Year, Month: j.Month}
+}
+
+// Validate checks if the YearMonth is valid
+func (ym YearMonth) Validate() error {
+	if ym.Year < MinYear || ym.Year > MaxYear {
+		return ErrOutOfRange
+	}
+	if ym.Month < 1 || ym.Month > 12 {
+		return ErrInvalidMonth
+	}
+	return nil
+}
+
+// String returns a string representation in yyyy/MM format
+func (ym YearMonth) String() string {
+	return fmt.Sprintf("%04d/%02d", ym.Year, ym.Month)
+}
+
+// Format formats the YearMonth according to the given layout.
+// Supported tokens: yyyy, yy, MMMM, MMM, MM, M
+func (ym YearMonth) Format(layout string) string {
+	return formatLayout(layout, yearMonthTokens, func(token string) string {
+		return formatField(token, ym.Year, ym.Month, 0)
+	})
+}
+
+// ParseYearMonth parses a year and month according to the given layout.
+// Supported tokens: yyyy, yy, MMMM, MMM, MM, M
+// Two-digit years are resolved as by Parse, from the current date.
+func ParseYearMonth(layout, value string) (YearMonth, error) {
+	f, err := parseFields(layout, value, yearMonthTokens, ParseOptions{})
+	if err != nil {
+		return YearMonth{}, err
+	}
+	ym := YearMonth{Year: f.year, Month: f.month}
+	if err := ym.Validate(); err != nil {
+		return YearMonth{}, fmt.Errorf("%w: %v", ErrInvalidDate, err)
+	}
+	return ym, nil
+}
+
+// Compare returns -1 if ym is before other, 0 if they are equal,
+// and +1 if ym is after other
+func (ym YearMonth) Compare(other YearMonth) int {
+	return compareInts(ym.index(), other.index())
+}
+
+// Before returns true if ym is before other
+func (ym YearMonth) Before(other YearMonth) bool {
+	return ym.index() < other.index()
+}
+
+// After returns true if ym is after other
+func (ym YearMonth) After(other YearMonth) bool {
+	return ym.index() > other.index()
+}
+
+// Equal returns true if ym equals other
+func (ym YearMonth) Equal(other YearMonth) bool {
+	return ym == other
+}
+
+// AddMonths adds n months and returns a new YearMonth
+func (ym YearMonth) AddMonths(n int) YearMonth {
+	return yearMonthFromIndex(ym.index() + n)
+}
+
+// AddYears adds n years and returns a new YearMonth
+func (ym YearMonth) AddYears(n int) YearMonth {
+	return YearMonth{Year: ym.Year + n, Month: ym.Month}
+}
+
+// MonthsBetween returns the number of months from other to ym
+func (ym YearMonth) MonthsBetween(other YearMonth) int {
+	return ym.index() - other.index()
+}
+
+// Days returns the number of days in the month
+func (ym YearMonth) Days() int {
+	return daysInJalaliMonth(ym.Year, ym.Month)
+}
+
+// AtDay returns the given day of the month
+func (ym YearMonth) AtDay(day int) (JalaliDate, error) {
+	return New(ym.Year, ym.Month, day)
+}
+
+// FirstDay returns the first day of the month
+func (ym YearMonth) FirstDay() JalaliDate {
+	return JalaliDate{Year: ym.Year, Month: ym.Month, Day: 1}
+}
+
+// LastDay returns the last day of the month
+func (ym YearMonth) LastDay() JalaliDate {
+	return JalaliDate{Year: ym.Year, Month: ym.Month, Day: ym.Days()}
+}
+
+// Range returns the range covering the month
+func (ym YearMonth) Range() Range {
+	return Range{Start: ym.FirstDay(), End: ym.LastDay()}
+}
+
+// Quarter returns the quarter containing the month
+func (ym YearMonth) Quarter() YearQuarter {
+	return YearQuarter{Year: ym.Year, Quarter: (ym.Month-1)/3 + 1}
+}
+
+// IsZero reports whether ym is the zero YearMonth
+func (ym YearMonth) IsZero() bool {
+	return ym == YearMonth{}
+}
+
+// MarshalJSON implements json.Marshaler. The month is encoded as "yyyy-MM",
+// and the zero YearMonth as null.
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	if ym.IsZero() {
+		return []byte("null"), nil
+	}
+	if err := ym.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(ym.Format("yyyy-MM"))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts "yyyy-MM" and
+// "yyyy/MM" with Persian or Latin digits, and null for the zero YearMonth.
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	s, ok, err := unquoteJSON(data)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		*ym = YearMonth{}
+		return nil
+	}
+	v, err := ParseYearMonth("yyyy-MM", s)
+	if err != nil {
+		alt, altErr := ParseYearMonth("yyyy/MM", s)
+		if altErr != nil {
+			return err
+		}
+		v = alt
+	}
+	*ym = v
+	return nil
+}
+
+// index returns the number of months since the start of year 0
+func (ym YearMonth) index() int {
+	return ym.Year*12 + ym.Month - 1
+}
+
+// yearMonthFromIndex is the inverse of YearMonth.index
+func yearMonthFromIndex(i int) YearMonth {
+	return YearMonth{Year: floorDiv(i, 12), Month: floorMod(i, 12) + 1}
+}
+
+// compareInts returns -1, 0 or +1 depending on whether a is less than,
+// equal to or greater than b
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// unquoteJSON decodes a JSON string. ok is false for a JSON null.
+func unquoteJSON(data []byte) (s string, ok bool, err error) {
+	if string(data) == "null" {
+		return "", false, nil
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", false, fmt.Errorf("%w: %v", ErrParseFailure, err)
+	}
+	return s, true, nil
+}
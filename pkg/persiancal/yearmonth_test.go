@@ -0,0 +1,113 @@
+package persiancal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestYearMonthFormatParse(t *testing.T) {
+	ym := YearMonth{1404, 8}
+	tests := []struct {
+		layout, want string
+	}{
+		{"yyyy/MM", "1404/08"},
+		{"yy-M", "04-8"},
+		{"MMMM yyyy", "آبان 1404"},
+		{"MMM yyyy", "Aban 1404"},
+	}
+	for _, tt := range tests {
+		if got := ym.Format(tt.layout); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+		if got, err := ParseYearMonth(tt.layout, tt.want); err != nil || got != ym {
+			t.Errorf("ParseYearMonth(%q, %q) = %v, %v; want %v", tt.layout, tt.want, got, err, ym)
+		}
+	}
+
+	if got, err := ParseYearMonth("yyyy/MM", "۱۴۰۴/۰۸"); err != nil || got != ym {
+		t.Errorf("ParseYearMonth(Persian digits) = %v, %v; want %v", got, err, ym)
+	}
+	if _, err := ParseYearMonth("yyyy/MM", "1404/13"); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("ParseYearMonth(1404/13) error = %v, want ErrInvalidDate", err)
+	}
+	if _, err := ParseYearMonth("yyyy/MM", "1404-08"); !errors.Is(err, ErrParseFailure) {
+		t.Errorf("ParseYearMonth(1404-08) error = %v, want ErrParseFailure", err)
+	}
+}
+
+func TestYearMonthArithmetic(t *testing.T) {
+	ym := YearMonth{1404, 8}
+	tests := []struct {
+		n    int
+		want YearMonth
+	}{
+		{0, YearMonth{1404, 8}},
+		{1, YearMonth{1404, 9}},
+		{4, YearMonth{1404, 12}},
+		{5, YearMonth{1405, 1}},
+		{-8, YearMonth{1403, 12}},
+		{-20, YearMonth{1402, 12}},
+		{29, YearMonth{1407, 1}},
+	}
+	for _, tt := range tests {
+		got := ym.AddMonths(tt.n)
+		if got != tt.want {
+			t.Errorf("%v.AddMonths(%d) = %v, want %v", ym, tt.n, got, tt.want)
+		}
+		if d := got.MonthsBetween(ym); d != tt.n {
+			t.Errorf("%v.MonthsBetween(%v) = %d, want %d", got, ym, d, tt.n)
+		}
+	}
+
+	if got := ym.AddYears(-1); got != (YearMonth{1403, 8}) {
+		t.Errorf("AddYears(-1) = %v, want 1403/08", got)
+	}
+	if !ym.Before(YearMonth{1405, 1}) || !ym.After(YearMonth{1404, 7}) || ym.Compare(ym) != 0 {
+		t.Errorf("comparisons of %v are wrong", ym)
+	}
+	if got := ym.Quarter(); got != (YearQuarter{1404, 3}) {
+		t.Errorf("Quarter() = %v, want 1404-Q3", got)
+	}
+
+	// Esfand has 30 days in leap years only
+	if d := (YearMonth{1403, 12}).Days(); d != 30 {
+		t.Errorf("1403/12 has %d days, want 30", d)
+	}
+	if r := (YearMonth{1404, 12}).Range(); r.End != (JalaliDate{1404, 12, 29}) {
+		t.Errorf("1404/12 Range() = %s, want it to end on 1404/12/29", r)
+	}
+}
+
+func TestYearMonthJSON(t *testing.T) {
+	type row struct {
+		Period YearMonth
+		Unset  YearMonth
+	}
+	in := row{Period: YearMonth{1404, 8}}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Period":"1404-08","Unset":null}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+
+	out := row{Unset: YearMonth{1400, 1}}
+	if err := json.Unmarshal(data, &out); err != nil || out != in {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", data, out, err, in)
+	}
+
+	var ym YearMonth
+	if err := json.Unmarshal([]byte(`"۱۴۰۴/۰۸"`), &ym); err != nil || ym != (YearMonth{1404, 8}) {
+		t.Errorf("Unmarshal(yyyy/MM with Persian digits) = %v, %v", ym, err)
+	}
+	for _, bad := range []string{`"1404-13"`, `"1404"`, `1404`} {
+		if err := json.Unmarshal([]byte(bad), &ym); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, want an error", bad, ym)
+		}
+	}
+	if _, err := json.Marshal(YearMonth{1404, 13}); !errors.Is(err, ErrInvalidMonth) {
+		t.Errorf("Marshal(1404/13) error = %v, want ErrInvalidMonth", err)
+	}
+}
@@ -0,0 +1,163 @@
+package persiancal
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// YearQuarter represents a quarter of a particular Jalali year. Quarters
+// follow the Iranian fiscal layout: Q1 is Farvardin to Khordad, Q2 Tir to
+// Shahrivar, Q3 Mehr to Azar and Q4 Dey to Esfand.
+type YearQuarter struct {
+	Year    int
+	Quarter int // 1-4
+}
+
+// yearQuarterTokens are the layout tokens understood by YearQuarter.
+// QQQ is the quarter with a Q prefix (Q3) and Q the bare number (3).
+var yearQuarterTokens = []string{"yyyy", "yy", "QQQ", "Q"}
+
+// NewYearQuarter creates a new YearQuarter with validation
+func NewYearQuarter(year, quarter int) (YearQuarter, error) {
+	yq := YearQuarter{Year: year, Quarter: quarter}
+	if err := yq.Validate(); err != nil {
+		return YearQuarter{}, err
+	}
+	return yq, nil
+}
+
+// YearQuarterOf returns the quarter containing j
+func YearQuarterOf(j JalaliDate) YearQuarter {
+	return YearQuarter{Year: j.Year, Quarter: (j.Month-1)/3 + 1}
+}
+
+// Validate checks if the YearQuarter is valid
+func (yq YearQuarter) Validate() error {
+	if yq.Year < MinYear || yq.Year > MaxYear {
+		return ErrOutOfRange
+	}
+	if yq.Quarter < 1 || yq.Quarter > 4 {
+		return ErrInvalidQuarter
+	}
+	return nil
+}
+
+// String returns a string representation in yyyy-QQQ format, e.g. 1404-Q3
+func (yq YearQuarter) String() string {
+	return yq.Format("yyyy-QQQ")
+}
+
+// Format formats the YearQuarter according to the given layout.
+// Supported tokens: yyyy, yy, QQQ (e.g. Q3), Q (e.g. 3)
+func (yq YearQuarter) Format(layout string) string {
+	return formatLayout(layout, yearQuarterTokens, func(token string) string {
+		return formatField(token, yq.Year, yq.FirstMonth().Month, 0)
+	})
+}
+
+// ParseYearQuarter parses a year and quarter according to the given layout.
+// Supported tokens: yyyy, yy, QQQ (e.g. Q3), Q (e.g. 3)
+func ParseYearQuarter(layout, value string) (YearQuarter, error) {
+	f, err := parseFields(layout, value, yearQuarterTokens, ParseOptions{})
+	if err != nil {
+		return YearQuarter{}, err
+	}
+	yq := YearQuarter{Year: f.year, Quarter: f.quarter}
+	if err := yq.Validate(); err != nil {
+		return YearQuarter{}, fmt.Errorf("%w: %v", ErrInvalidDate, err)
+	}
+	return yq, nil
+}
+
+// Compare returns -1 if yq is before other, 0 if they are equal,
+// and +1 if yq is after other
+func (yq YearQuarter) Compare(other YearQuarter) int {
+	return compareInts(yq.index(), other.index())
+}
+
+// Before returns true if yq is before other
+func (yq YearQuarter) Before(other YearQuarter) bool {
+	return yq.index() < other.index()
+}
+
+// After returns true if yq is after other
+func (yq YearQuarter) After(other YearQuarter) bool {
+	return yq.index() > other.index()
+}
+
+// Equal returns true if yq equals other
+func (yq YearQuarter) Equal(other YearQuarter) bool {
+	return yq == other
+}
+
+// AddQuarters adds n quarters and returns a new YearQuarter
+func (yq YearQuarter) AddQuarters(n int) YearQuarter {
+	i := yq.index() + n
+	return YearQuarter{Year: floorDiv(i, 4), Quarter: floorMod(i, 4) + 1}
+}
+
+// AddYears adds n years and returns a new YearQuarter
+func (yq YearQuarter) AddYears(n int) YearQuarter {
+	return YearQuarter{Year: yq.Year + n, Quarter: yq.Quarter}
+}
+
+// QuartersBetween returns the number of quarters from other to yq
+func (yq YearQuarter) QuartersBetween(other YearQuarter) int {
+	return yq.index() - other.index()
+}
+
+// FirstMonth returns the first month of the quarter
+func (yq YearQuarter) FirstMonth() YearMonth {
+	return YearMonth{Year: yq.Year, Month: (yq.Quarter-1)*3 + 1}
+}
+
+// LastMonth returns the last month of the quarter
+func (yq YearQuarter) LastMonth() YearMonth {
+	return yq.FirstMonth().AddMonths(2)
+}
+
+// Range returns the range covering the quarter
+func (yq YearQuarter) Range() Range {
+	return Range{Start: yq.FirstMonth().FirstDay(), End: yq.LastMonth().LastDay()}
+}
+
+// IsZero reports whether yq is the zero YearQuarter
+func (yq YearQuarter) IsZero() bool {
+	return yq == YearQuarter{}
+}
+
+// MarshalJSON implements json.Marshaler. The quarter is encoded as
+// "yyyy-QQQ", e.g. "1404-Q3", and the zero YearQuarter as null.
+func (yq YearQuarter) MarshalJSON() ([]byte, error) {
+	if yq.IsZero() {
+		return []byte("null"), nil
+	}
+	if err := yq.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(yq.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts "yyyy-QQQ", and
+// null for the zero YearQuarter.
+func (yq *YearQuarter) UnmarshalJSON(data []byte) error {
+	s, ok, err := unquoteJSON(data)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		*yq = YearQuarter{}
+		return nil
+	}
+	v, err := ParseYearQuarter("yyyy-QQQ", s)
+	if err != nil {
+		return err
+	}
+	*yq = v
+	return nil
+}
+
+// index returns the number of quarters since the start of year 0
+func (yq YearQuarter) index() int {
+	return yq.Year*4 + yq.Quarter - 1
+}
@@ -0,0 +1,101 @@
+package persiancal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestYearQuarterFormatParse(t *testing.T) {
+	yq := YearQuarter{1403, 3}
+	tests := []struct {
+		layout, want string
+	}{
+		{"yyyy-QQQ", "1403-Q3"},
+		{"yy Q", "03 3"},
+		{"QQQ/yyyy", "Q3/1403"},
+	}
+	for _, tt := range tests {
+		if got := yq.Format(tt.layout); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+		if got, err := ParseYearQuarter(tt.layout, tt.want); err != nil || got != yq {
+			t.Errorf("ParseYearQuarter(%q, %q) = %v, %v; want %v", tt.layout, tt.want, got, err, yq)
+		}
+	}
+
+	if got, err := ParseYearQuarter("yyyy-QQQ", "۱۴۰۳-q۳"); err != nil || got != yq {
+		t.Errorf("ParseYearQuarter(Persian digits) = %v, %v; want %v", got, err, yq)
+	}
+	if _, err := ParseYearQuarter("yyyy-QQQ", "1403-Q5"); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("ParseYearQuarter(1403-Q5) error = %v, want ErrInvalidDate", err)
+	}
+	if _, err := ParseYearQuarter("yyyy-QQQ", "1403-3"); !errors.Is(err, ErrParseFailure) {
+		t.Errorf("ParseYearQuarter(1403-3) error = %v, want ErrParseFailure", err)
+	}
+}
+
+func TestYearQuarterArithmetic(t *testing.T) {
+	yq := YearQuarter{1403, 3}
+	tests := []struct {
+		n    int
+		want YearQuarter
+	}{
+		{1, YearQuarter{1403, 4}},
+		{2, YearQuarter{1404, 1}},
+		{-2, YearQuarter{1403, 1}},
+		{-3, YearQuarter{1402, 4}},
+		{9, YearQuarter{1405, 4}},
+	}
+	for _, tt := range tests {
+		got := yq.AddQuarters(tt.n)
+		if got != tt.want {
+			t.Errorf("%v.AddQuarters(%d) = %v, want %v", yq, tt.n, got, tt.want)
+		}
+		if d := got.QuartersBetween(yq); d != tt.n {
+			t.Errorf("%v.QuartersBetween(%v) = %d, want %d", got, yq, d, tt.n)
+		}
+	}
+
+	if got := yq.AddYears(1); got != (YearQuarter{1404, 3}) {
+		t.Errorf("AddYears(1) = %v, want 1404-Q3", got)
+	}
+	if !yq.Before(YearQuarter{1403, 4}) || !yq.After(YearQuarter{1402, 4}) || yq.Compare(yq) != 0 {
+		t.Errorf("comparisons of %v are wrong", yq)
+	}
+	// Q3 is Mehr to Azar; Q4 of a leap year ends on 30 Esfand
+	if r := yq.Range(); r.Start != (JalaliDate{1403, 7, 1}) || r.End != (JalaliDate{1403, 9, 30}) {
+		t.Errorf("%v.Range() = %s, want 1403/07/01 - 1403/09/30", yq, r)
+	}
+	if r := (YearQuarter{1403, 4}).Range(); r.End != (JalaliDate{1403, 12, 30}) {
+		t.Errorf("1403-Q4 Range() = %s, want it to end on 1403/12/30", r)
+	}
+}
+
+func TestYearQuarterJSON(t *testing.T) {
+	type row struct {
+		Quarter YearQuarter
+		Unset   YearQuarter
+	}
+	in := row{Quarter: YearQuarter{1403, 3}}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Quarter":"1403-Q3","Unset":null}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+
+	out := row{Unset: YearQuarter{1400, 1}}
+	if err := json.Unmarshal(data, &out); err != nil || out != in {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", data, out, err, in)
+	}
+
+	var yq YearQuarter
+	if err := json.Unmarshal([]byte(`"1403-Q0"`), &yq); err == nil {
+		t.Errorf("Unmarshal(1403-Q0) = %v, want an error", yq)
+	}
+	if _, err := json.Marshal(YearQuarter{1403, 0}); !errors.Is(err, ErrInvalidQuarter) {
+		t.Errorf("Marshal(1403-Q0) error = %v, want ErrInvalidQuarter", err)
+	}
+}
@@ -0,0 +1,323 @@
+package persiancal
+
+import (
+	"fmt"
+	"time"
+)
+
+// ZoroastrianVariant selects one of the Zoroastrian calendars. All of them
+// have twelve 30-day months followed by the five Gatha days, and count years
+// from the accession of Yazdegerd III (the Yazdegerdi era).
+type ZoroastrianVariant int
+
+const (
+	// Fasli is the seasonal calendar. Its year starts on Nowruz, 1 Farvardin
+	// of the Jalali calendar, and adds a sixth Gatha day in Jalali leap years.
+	Fasli ZoroastrianVariant = iota
+
+	// Shahanshahi is the calendar of most Parsis. It has no leap years, so
+	// its new year drifts by a day every four years, and now falls in August.
+	Shahanshahi
+
+	// Qadimi is the calendar of some Parsis and Iranian Zoroastrians. It
+	// has no leap years and runs 30 days ahead of Shahanshahi.
+	Qadimi
+)
+
+// Constants for the Zoroastrian calendars
+const (
+	fasliYearOffset  = 10      // Jalali year minus Fasli year
+	shahanshahiEpoch = 1952093 // Julian day number of 1 Farvardin 1 Shahanshahi
+	qadimiEpoch      = 1952063 // Julian day number of 1 Farvardin 1 Qadimi
+	gathaMonth       = 13      // pseudo-month holding the Gatha days
+)
+
+// Built-in Zoroastrian calendars. The Gatha days are month 13.
+var (
+	FasliCalendar       Calendar = zoroastrian{Fasli}
+	ShahanshahiCalendar Calendar = zoroastrian{Shahanshahi}
+	QadimiCalendar      Calendar = zoroastrian{Qadimi}
+)
+
+// String returns the name of the variant
+func (v ZoroastrianVariant) String() string {
+	switch v {
+	case Fasli:
+		return "Fasli"
+	case Shahanshahi:
+		return "Shahanshahi"
+	case Qadimi:
+		return "Qadimi"
+	default:
+		return fmt.Sprintf("ZoroastrianVariant(%d)", int(v))
+	}
+}
+
+// Calendar returns the Calendar of the variant
+func (v ZoroastrianVariant) Calendar() Calendar {
+	return zoroastrian{v}
+}
+
+// Zoroastrian month names (1-indexed, 13 holds the Gatha days)
+var zoroastrianMonthNames = []MonthName{
+	{Persian: "", English: ""}, // placeholder
+	{Persian: "فروردین", English: "Farvardin"},
+	{Persian: "اردیبهشت", English: "Ardibehesht"},
+	{Persian: "خرداد", English: "Khordad"},
+	{Persian: "تیر", English: "Tir"},
+	{Persian: "امرداد", English: "Amordad"},
+	{Persian: "شهریور", English: "Shahrivar"},
+	{Persian: "مهر", English: "Mehr"},
+	{Persian: "آبان", English: "Aban"},
+	{Persian: "آذر", English: "Azar"},
+	{Persian: "دی", English: "Dey"},
+	{Persian: "بهمن", English: "Bahman"},
+	{Persian: "سپندارمذ", English: "Spendarmad"},
+	{Persian: "گاتاها", English: "Gatha"},
+}
+
+// Names of the 30 days of a Zoroastrian month (1-indexed)
+var zoroastrianDayNames = []MonthName{
+	{Persian: "", English: ""}, // placeholder
+	{Persian: "هرمزد", English: "Hormozd"},
+	{Persian: "بهمن", English: "Bahman"},
+	{Persian: "اردیبهشت", English: "Ardibehesht"},
+	{Persian: "شهریور", English: "Shahrivar"},
+	{Persian: "سپندارمذ", English: "Spendarmad"},
+	{Persian: "خرداد", English: "Khordad"},
+	{Persian: "امرداد", English: "Amordad"},
+	{Persian: "دی‌به‌آذر", English: "Dey-be-Azar"},
+	{Persian: "آذر", English: "Azar"},
+	{Persian: "آبان", English: "Aban"},
+	{Persian: "خور", English: "Khorshid"},
+	{Persian: "ماه", English: "Mah"},
+	{Persian: "تیر", English: "Tir"},
+	{Persian: "گوش", English: "Gosh"},
+	{Persian: "دی‌به‌مهر", English: "Dey-be-Mehr"},
+	{Persian: "مهر", English: "Mehr"},
+	{Persian: "سروش", English: "Sorush"},
+	{Persian: "رشن", English: "Rashn"},
+	{Persian: "فروردین", English: "Farvardin"},
+	{Persian: "ورهرام", English: "Bahram"},
+	{Persian: "رام", English: "Ram"},
+	{Persian: "باد", English: "Bad"},
+	{Persian: "دی‌به‌دین", English: "Dey-be-Din"},
+	{Persian: "دین", English: "Din"},
+	{Persian: "ارد", English: "Ard"},
+	{Persian: "اشتاد", English: "Ashtad"},
+	{Persian: "آسمان", English: "Asman"},
+	{Persian: "زامیاد", English: "Zamyad"},
+	{Persian: "مانتره‌سپند", English: "Mahraspand"},
+	{Persian: "انیران", English: "Aneran"},
+}
+
+// Names of the Gatha days (1-indexed). The sixth is only used in Fasli
+// leap years.
+var gathaDayNames = []MonthName{
+	{Persian: "", English: ""}, // placeholder
+	{Persian: "اهنود", English: "Ahunavad"},
+	{Persian: "اشتود", English: "Ushtavad"},
+	{Persian: "سپنتمد", English: "Spentamad"},
+	{Persian: "وهوخشتر", English: "Vohukhshathra"},
+	{Persian: "وهیشتوایشت", English: "Vahishtoisht"},
+	{Persian: "اورداد", English: "Avardad"},
+}
+
+// ZoroastrianDayName returns the names of a day. month is 1-12 for the
+// ordinary months, where every day has its own name, or 13 for the Gatha
+// days. An empty MonthName is returned for days that do not exist.
+func ZoroastrianDayName(month, day int) MonthName {
+	if month == gathaMonth {
+		return monthNameIn(gathaDayNames, day)
+	}
+	if month < 1 || month > 12 {
+		return MonthName{}
+	}
+	return monthNameIn(zoroastrianDayNames, day)
+}
+
+// zoroastrian implements Calendar for a Zoroastrian variant
+type zoroastrian struct {
+	variant ZoroastrianVariant
+}
+
+func (c zoroastrian) Name() string {
+	switch c.variant {
+	case Shahanshahi:
+		return "shahanshahi"
+	case Qadimi:
+		return "qadimi"
+	default:
+		return "fasli"
+	}
+}
+
+func (c zoroastrian) ToDayNumber(year, month, day int) (DayNumber, error) {
+	if err := validateDate(c, year, month, day); err != nil {
+		return 0, err
+	}
+	return DayNumber(c.yearStart(year) + (month-1)*30 + day - 1), nil
+}
+
+func (c zoroastrian) FromDayNumber(n DayNumber) (year, month, day int) {
+	switch c.variant {
+	case Shahanshahi:
+		year = floorDiv(int(n)-shahanshahiEpoch, 365) + 1
+	case Qadimi:
+		year = floorDiv(int(n)-qadimiEpoch, 365) + 1
+	default:
+		jy, _, _ := jdnToJalali(int(n))
+		year = jy - fasliYearOffset
+	}
+
+	doy := int(n) - c.yearStart(year)
+	month = min(doy/30+1, gathaMonth)
+	day = doy - (month-1)*30 + 1
+	return
+}
+
+func (c zoroastrian) DaysInMonth(year, month int) int {
+	switch {
+	case month < 1 || month > gathaMonth:
+		return 0
+	case month < gathaMonth:
+		return 30
+	case c.IsLeap(year):
+		return 6
+	default:
+		return 5
+	}
+}
+
+func (zoroastrian) MonthsInYear(int) int { return gathaMonth }
+
+func (c zoroastrian) IsLeap(year int) bool {
+	return c.variant == Fasli && isJalaliLeap(year+fasliYearOffset)
+}
+
+func (zoroastrian) MonthName(month int) MonthName {
+	return monthNameIn(zoroastrianMonthNames, month)
+}
+
+// yearStart returns the Julian Day Number of 1 Farvardin of year
+func (c zoroastrian) yearStart(year int) int {
+	switch c.variant {
+	case Shahanshahi:
+		return shahanshahiEpoch + (year-1)*365
+	case Qadimi:
+		return qadimiEpoch + (year-1)*365
+	default:
+		return jalaliToJDN(year+fasliYearOffset, 1, 1)
+	}
+}
+
+// ZoroastrianDate represents a date in one of the Zoroastrian calendars.
+// Months 1-12 have 30 days; month 13 holds the Gatha days.
+type ZoroastrianDate struct {
+	Year    int
+	Month   int // 1-13
+	Day     int // 1-30, or 1-6 for the Gatha days
+	Variant ZoroastrianVariant
+}
+
+// zoroastrianTokens are the layout tokens understood by ZoroastrianDate.Format
+var zoroastrianTokens = []string{"yyyy", "yy", "MMMM", "MMM", "MM", "M", "dddd", "ddd", "dd", "d"}
+
+// NewZoroastrian creates a new ZoroastrianDate with validation
+func NewZoroastrian(variant ZoroastrianVariant, year, month, day int) (ZoroastrianDate, error) {
+	z := ZoroastrianDate{Year: year, Month: month, Day: day, Variant: variant}
+	if err := z.Validate(); err != nil {
+		return ZoroastrianDate{}, err
+	}
+	return z, nil
+}
+
+// ZoroastrianOf returns the date of j in the given Zoroastrian calendar
+func ZoroastrianOf(j JalaliDate, variant ZoroastrianVariant) ZoroastrianDate {
+	n := DayNumber(jalaliToJDN(j.Year, j.Month, j.Day))
+	y, m, d := variant.Calendar().FromDayNumber(n)
+	return ZoroastrianDate{Year: y, Month: m, Day: d, Variant: variant}
+}
+
+// Validate checks if the ZoroastrianDate is valid
+func (z ZoroastrianDate) Validate() error {
+	if z.Variant < Fasli || z.Variant > Qadimi {
+		return fmt.Errorf("%w: unknown variant %v", ErrInvalidDate, z.Variant)
+	}
+	return validateDate(z.Variant.Calendar(), z.Year, z.Month, z.Day)
+}
+
+// DayNumber returns the Julian Day Number of the date
+func (z ZoroastrianDate) DayNumber() (DayNumber, error) {
+	if err := z.Validate(); err != nil {
+		return 0, err
+	}
+	return z.Variant.Calendar().ToDayNumber(z.Year, z.Month, z.Day)
+}
+
+// JalaliDate converts the date to the Jalali calendar.
+// Returns an error if the date is invalid or outside the supported range.
+func (z ZoroastrianDate) JalaliDate() (JalaliDate, error) {
+	n, err := z.DayNumber()
+	if err != nil {
+		return JalaliDate{}, err
+	}
+	return n.Jalali()
+}
+
+// ToGregorian converts the date to a Gregorian time.Time at midnight UTC
+func (z ZoroastrianDate) ToGregorian() time.Time {
+	n := zoroastrian{z.Variant}.yearStart(z.Year) + (z.Month-1)*30 + z.Day - 1
+	return DayNumber(n).Time(time.UTC)
+}
+
+// IsGatha reports whether the date is one of the Gatha days
+func (z ZoroastrianDate) IsGatha() bool {
+	return z.Month == gathaMonth
+}
+
+// MonthName returns the Persian name of the month
+func (z ZoroastrianDate) MonthName() string {
+	return monthNameIn(zoroastrianMonthNames, z.Month).Persian
+}
+
+// MonthNameEnglish returns the English name of the month
+func (z ZoroastrianDate) MonthNameEnglish() string {
+	return monthNameIn(zoroastrianMonthNames, z.Month).English
+}
+
+// DayName returns the Persian name of the day, e.g. هرمزد
+func (z ZoroastrianDate) DayName() string {
+	return ZoroastrianDayName(z.Month, z.Day).Persian
+}
+
+// DayNameEnglish returns the English name of the day, e.g. Hormozd
+func (z ZoroastrianDate) DayNameEnglish() string {
+	return ZoroastrianDayName(z.Month, z.Day).English
+}
+
+// String returns a string representation in yyyy/MM/dd format
+func (z ZoroastrianDate) String() string {
+	return fmt.Sprintf("%04d/%02d/%02d", z.Year, z.Month, z.Day)
+}
+
+// Format formats the ZoroastrianDate according to the given layout.
+// Supported tokens are those of JalaliDate.Format, with Zoroastrian month
+// names, plus:
+//   - dddd: Persian day name (e.g., هرمزد)
+//   - ddd: English day name (e.g., Hormozd)
+func (z ZoroastrianDate) Format(layout string) string {
+	return formatLayout(layout, zoroastrianTokens, func(token string) string {
+		switch token {
+		case "MMMM":
+			return z.MonthName()
+		case "MMM":
+			return z.MonthNameEnglish()
+		case "dddd":
+			return z.DayName()
+		case "ddd":
+			return z.DayNameEnglish()
+		}
+		return formatField(token, z.Year, z.Month, z.Day)
+	})
+}
@@ -0,0 +1,66 @@
+package persiancal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestZoroastrianNewYear(t *testing.T) {
+	tests := []struct {
+		cal  Calendar
+		year int
+		want string
+	}{
+		{FasliCalendar, 1393, "2024-03-20"},
+		{FasliCalendar, 1394, "2025-03-21"},
+		{QadimiCalendar, 1395, "2025-07-16"},
+		{ShahanshahiCalendar, 1395, "2025-08-15"},
+	}
+	for _, tt := range tests {
+		y, m, d, err := Convert(tt.cal, GregorianCalendar, tt.year, 1, 1)
+		if err != nil {
+			t.Errorf("%s %d: %v", tt.cal.Name(), tt.year, err)
+			continue
+		}
+		if got := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC).Format(time.DateOnly); got != tt.want {
+			t.Errorf("%s %d/1/1 = %s, want %s", tt.cal.Name(), tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestFasliFollowsJalali(t *testing.T) {
+	z := ZoroastrianOf(JalaliDate{1404, 1, 1}, Fasli)
+	if z.Year != 1394 || z.Month != 1 || z.Day != 1 {
+		t.Errorf("ZoroastrianOf(1404/01/01, Fasli) = %s, want 1394/01/01", z)
+	}
+
+	// Fasli 1393 is Jalali 1403, a leap year with a sixth Gatha day
+	if !FasliCalendar.IsLeap(1393) || FasliCalendar.IsLeap(1394) {
+		t.Errorf("Fasli leap years = %v, %v; want 1393 only",
+			FasliCalendar.IsLeap(1393), FasliCalendar.IsLeap(1394))
+	}
+	z = ZoroastrianOf(JalaliDate{1403, 12, 30}, Fasli)
+	if z.Year != 1393 || z.Month != gathaMonth || z.Day != 6 {
+		t.Errorf("ZoroastrianOf(1403/12/30, Fasli) = %+v, want the sixth Gatha day of 1393", z)
+	}
+	if j, err := z.JalaliDate(); err != nil || j != (JalaliDate{1403, 12, 30}) {
+		t.Errorf("%s.JalaliDate() = %s, %v", z, j, err)
+	}
+}
+
+func TestZoroastrianDayName(t *testing.T) {
+	tests := []struct {
+		month, day int
+		want       MonthName
+	}{
+		{1, 1, MonthName{Persian: "هرمزد", English: "Hormozd"}},
+		{5, 30, MonthName{Persian: "انیران", English: "Aneran"}},
+		{gathaMonth, 6, MonthName{Persian: "اورداد", English: "Avardad"}},
+		{gathaMonth, 7, MonthName{}},
+	}
+	for _, tt := range tests {
+		if got := ZoroastrianDayName(tt.month, tt.day); got != tt.want {
+			t.Errorf("ZoroastrianDayName(%d, %d) = %v, want %v", tt.month, tt.day, got, tt.want)
+		}
+	}
+}